@@ -395,6 +395,449 @@ func TestIsTypeOfUsedToResolveRuntimeTypeForUnion(t *testing.T) {
 	}
 }
 
+type testTaggedPet struct {
+	Type  string `json:"-"`
+	Name  string `json:"name"`
+	Woofs bool   `json:"woofs"`
+	Meows bool   `json:"meows"`
+}
+
+func TestResolveTypeByDiscriminatorUsedToResolveRuntimeTypeForUnion(t *testing.T) {
+
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"woofs": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	catType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Cat",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"meows": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	petType := graphql.NewUnion(graphql.UnionConfig{
+		Name: "Pet",
+		Types: []*graphql.Object{
+			dogType, catType,
+		},
+		ResolveType: graphql.ResolveTypeByDiscriminator(map[string]*graphql.Object{
+			"dog": dogType,
+			"cat": catType,
+		}),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": &graphql.Field{
+					Type: graphql.NewList(petType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return []interface{}{
+							testTaggedPet{Type: "dog", Name: "Odie", Woofs: true},
+							&testTaggedPet{Type: "cat", Name: "Garfield"},
+							&testTaggedPet{Type: "bird", Name: "Tweety"},
+						}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `{
+      pets {
+        ... on Dog {
+          name
+          woofs
+        }
+        ... on Cat {
+          name
+          meows
+        }
+      }
+    }`
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"pets": []interface{}{
+				map[string]interface{}{
+					"name":  "Odie",
+					"woofs": bool(true),
+				},
+				map[string]interface{}{
+					"name":  "Garfield",
+					"meows": bool(false),
+				},
+				nil,
+			},
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: `Abstract type "Pet" must resolve to an Object type at runtime for field "Query.pets" with value "&{bird Tweety false false}", received "<nil>".`,
+				Locations: []location.SourceLocation{
+					{
+						Line:   2,
+						Column: 7,
+					},
+				},
+				Path: []interface{}{
+					"pets",
+					2,
+				},
+			},
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestResolveTypeRegisteredOnTheSchemaUsedToResolveRuntimeType(t *testing.T) {
+
+	petType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		Interfaces: []*graphql.Interface{
+			petType,
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"woofs": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	catType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Cat",
+		Interfaces: []*graphql.Interface{
+			petType,
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"meows": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"pets": &graphql.Field{
+				Type: graphql.NewList(petType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return []interface{}{
+						&testDog{"Odie", true},
+						&testCat{"Garfield", false},
+					}, nil
+				},
+			},
+		},
+	})
+
+	// neither Pet nor its implementations know how to resolve its runtime type
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: query,
+		Types: []graphql.Type{catType, dogType},
+	})
+	if err == nil {
+		t.Fatalf("expected the schema to be rejected")
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: query,
+		Types: []graphql.Type{catType, dogType},
+		TypeResolvers: map[string]graphql.ResolveTypeFn{
+			"Pet": func(p graphql.ResolveTypeParams) *graphql.Object {
+				switch p.Value.(type) {
+				case *testDog:
+					return dogType
+				case *testCat:
+					return catType
+				}
+				return nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"pets": []interface{}{
+				map[string]interface{}{
+					"name":  "Odie",
+					"woofs": bool(true),
+				},
+				map[string]interface{}{
+					"name":  "Garfield",
+					"meows": bool(false),
+				},
+			},
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+      pets {
+        name
+        ... on Dog {
+          woofs
+        }
+        ... on Cat {
+          meows
+        }
+      }
+    }`,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIsTypeOfUsedWhenResolveTypeReturnsNil(t *testing.T) {
+
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"woofs": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	catType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Cat",
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			_, ok := p.Value.(*testCat)
+			return ok
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"meows": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	// ResolveType only knows about dogs and leaves everything else to IsTypeOf.
+	petType := graphql.NewUnion(graphql.UnionConfig{
+		Name: "Pet",
+		Types: []*graphql.Object{
+			dogType, catType,
+		},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			if _, ok := p.Value.(*testDog); ok {
+				return dogType
+			}
+			return nil
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": &graphql.Field{
+					Type: graphql.NewList(petType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return []interface{}{
+							&testDog{"Odie", true},
+							&testCat{"Garfield", false},
+						}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `{
+      pets {
+        __typename
+        ... on Dog {
+          name
+          woofs
+        }
+        ... on Cat {
+          name
+          meows
+        }
+      }
+    }`
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"pets": []interface{}{
+				map[string]interface{}{
+					"__typename": "Dog",
+					"name":       "Odie",
+					"woofs":      bool(true),
+				},
+				map[string]interface{}{
+					"__typename": "Cat",
+					"name":       "Garfield",
+					"meows":      bool(false),
+				},
+			},
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestUnionResolvesMembersReturnedAsPointersOrValues(t *testing.T) {
+
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			switch p.Value.(type) {
+			case testDog, *testDog:
+				return true
+			}
+			return false
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"woofs": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	catType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Cat",
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			switch p.Value.(type) {
+			case testCat, *testCat:
+				return true
+			}
+			return false
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"meows": &graphql.Field{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+	petType := graphql.NewUnion(graphql.UnionConfig{
+		Name: "Pet",
+		Types: []*graphql.Object{
+			dogType, catType,
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet": &graphql.Field{
+					Type: petType,
+					Args: graphql.FieldConfigArgument{
+						"kind": &graphql.ArgumentConfig{
+							Type: graphql.String,
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						switch p.Args["kind"] {
+						case "dogValue":
+							return testDog{"Odie", true}, nil
+						case "dogPointer":
+							return &testDog{"Snoopy", true}, nil
+						case "catValue":
+							return testCat{"Tom", false}, nil
+						}
+						return &testCat{"Garfield", false}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `query Pet($kind: String) {
+      pet(kind: $kind) {
+        __typename
+        ... on Dog {
+          name
+          woofs
+        }
+        ... on Cat {
+          name
+          meows
+        }
+      }
+    }`
+	tests := []struct {
+		kind     string
+		expected map[string]interface{}
+	}{
+		{"dogValue", map[string]interface{}{"__typename": "Dog", "name": "Odie", "woofs": true}},
+		{"dogPointer", map[string]interface{}{"__typename": "Dog", "name": "Snoopy", "woofs": true}},
+		{"catValue", map[string]interface{}{"__typename": "Cat", "name": "Tom", "meows": false}},
+		{"catPointer", map[string]interface{}{"__typename": "Cat", "name": "Garfield", "meows": false}},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  query,
+			VariableValues: map[string]interface{}{"kind": test.kind},
+		})
+		expected := &graphql.Result{
+			Data: map[string]interface{}{
+				"pet": test.expected,
+			},
+		}
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("Unexpected result for %v, Diff: %v", test.kind, testutil.Diff(expected, result))
+		}
+	}
+}
+
 func TestResolveTypeOnInterfaceYieldsUsefulError(t *testing.T) {
 
 	var dogType *graphql.Object
@@ -531,6 +974,192 @@ func TestResolveTypeOnInterfaceYieldsUsefulError(t *testing.T) {
 	}
 }
 
+func TestIsTypeOfOnInterfaceYieldsUsefulErrorWhenNoTypeMatches(t *testing.T) {
+
+	petType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		Interfaces: []*graphql.Interface{
+			petType,
+		},
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			_, ok := p.Value.(*testDog)
+			return ok
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": &graphql.Field{
+					Type: graphql.NewList(petType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return []interface{}{
+							&testDog{"Odie", true},
+							&testHuman{"Jon"},
+						}, nil
+					},
+				},
+			},
+		}),
+		Types: []graphql.Type{dogType},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `{
+      pets {
+        name
+      }
+    }`
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"pets": []interface{}{
+				map[string]interface{}{
+					"name": "Odie",
+				},
+				nil,
+			},
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: `Abstract type "Pet" must resolve to an Object type at runtime for field "Query.pets" with value "&{Jon}", received "<nil>".`,
+				Locations: []location.SourceLocation{
+					{
+						Line:   2,
+						Column: 7,
+					},
+				},
+				Path: []interface{}{
+					"pets",
+					1,
+				},
+			},
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIsTypeOfOnUnionYieldsUsefulErrorWhenSeveralTypesMatch(t *testing.T) {
+
+	dogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Dog",
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			_, ok := p.Value.(*testDog)
+			return ok
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	// Cat mistakenly claims dogs too.
+	catType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Cat",
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+			switch p.Value.(type) {
+			case *testCat, *testDog:
+				return true
+			}
+			return false
+		},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	petType := graphql.NewUnion(graphql.UnionConfig{
+		Name:  "Pet",
+		Types: []*graphql.Object{dogType, catType},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": &graphql.Field{
+					Type: graphql.NewList(petType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return []interface{}{
+							&testDog{"Odie", true},
+							&testCat{"Garfield", false},
+						}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	query := `{
+      pets {
+        __typename
+        ... on Cat {
+          name
+        }
+      }
+    }`
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"pets": []interface{}{
+				nil,
+				map[string]interface{}{
+					"__typename": "Cat",
+					"name":       "Garfield",
+				},
+			},
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: `Abstract type "Pet" is ambiguous for field "Query.pets" with value "&{Odie true}", both "Dog" and "Cat" match it.`,
+				Locations: []location.SourceLocation{
+					{
+						Line:   2,
+						Column: 7,
+					},
+				},
+				Path: []interface{}{
+					"pets",
+					0,
+				},
+			},
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func TestResolveTypeOnUnionYieldsUsefulError(t *testing.T) {
 
 	humanType := graphql.NewObject(graphql.ObjectConfig{
@@ -0,0 +1,523 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// BuildSchema builds a Schema from a GraphQL schema language (SDL) document.
+//
+// Object and interface fields are resolved with DefaultResolveFn, which reads
+// the property of the same name from the source map or struct. Interfaces and
+// unions resolve their runtime type from the "__typename" property of the
+// resolved value. Custom scalars pass values through unchanged.
+//
+// Example:
+//
+//	schema, err := BuildSchema(`
+//	  type Query {
+//	    hero: Character
+//	  }
+//	  type Character {
+//	    name: String
+//	    friends: [Character]
+//	  }
+//	`)
+func BuildSchema(sdl string) (Schema, error) {
+	AST, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(sdl),
+			Name: "GraphQL SDL",
+		}),
+	})
+	if err != nil {
+		return Schema{}, err
+	}
+	return BuildASTSchema(AST)
+}
+
+// BuildASTSchema builds a Schema from an already parsed schema language document.
+func BuildASTSchema(doc *ast.Document) (Schema, error) {
+	if err := invariant(doc != nil, "Must provide a document ast."); err != nil {
+		return Schema{}, err
+	}
+
+	b := &schemaBuilder{
+		definitions: map[string]ast.Node{},
+		types:       map[string]Type{},
+	}
+	var (
+		schemaDef      *ast.SchemaDefinition
+		directiveDefs  []*ast.DirectiveDefinition
+		typeNames      []string
+		extensionNodes []*ast.TypeExtensionDefinition
+	)
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.SchemaDefinition:
+			if err := invariant(schemaDef == nil, "Must provide only one schema definition."); err != nil {
+				return Schema{}, err
+			}
+			schemaDef = def
+		case *ast.DirectiveDefinition:
+			directiveDefs = append(directiveDefs, def)
+		case *ast.TypeExtensionDefinition:
+			extensionNodes = append(extensionNodes, def)
+		case *ast.ScalarDefinition, *ast.ObjectDefinition, *ast.InterfaceDefinition,
+			*ast.UnionDefinition, *ast.EnumDefinition, *ast.InputObjectDefinition:
+			name := typeDefinitionName(def)
+			if _, ok := b.definitions[name]; ok {
+				return Schema{}, fmt.Errorf(`Type "%v" was defined more than once.`, name)
+			}
+			b.definitions[name] = def
+			typeNames = append(typeNames, name)
+		default:
+			return Schema{}, fmt.Errorf("Cannot build a schema from a document containing a %v.", def.GetKind())
+		}
+	}
+
+	// Build every named type upfront. Fields, interfaces and union members are
+	// supplied through thunks, so forward references and cycles work.
+	types := []Type{}
+	for _, name := range typeNames {
+		ttype, err := b.namedType(name)
+		if err != nil {
+			return Schema{}, err
+		}
+		types = append(types, ttype)
+	}
+
+	for _, ext := range extensionNodes {
+		if ext.Definition == nil || ext.Definition.Name == nil {
+			continue
+		}
+		name := ext.Definition.Name.Value
+		if _, ok := b.types[name].(*Object); !ok {
+			return Schema{}, fmt.Errorf(`Cannot extend type "%v" because it is not a defined object type.`, name)
+		}
+		b.extensions = append(b.extensions, ext.Definition)
+	}
+
+	operationTypes := map[string]string{}
+	if schemaDef != nil {
+		for _, opType := range schemaDef.OperationTypes {
+			if opType == nil || opType.Type == nil || opType.Type.Name == nil {
+				continue
+			}
+			operationTypes[opType.Operation] = opType.Type.Name.Value
+		}
+	} else {
+		for operation, name := range map[string]string{
+			ast.OperationTypeQuery:        "Query",
+			ast.OperationTypeMutation:     "Mutation",
+			ast.OperationTypeSubscription: "Subscription",
+		} {
+			if _, ok := b.definitions[name]; ok {
+				operationTypes[operation] = name
+			}
+		}
+	}
+
+	config := SchemaConfig{
+		Types: types,
+	}
+	var err error
+	if config.Query, err = b.operationType(operationTypes, ast.OperationTypeQuery); err != nil {
+		return Schema{}, err
+	}
+	if config.Mutation, err = b.operationType(operationTypes, ast.OperationTypeMutation); err != nil {
+		return Schema{}, err
+	}
+	if config.Subscription, err = b.operationType(operationTypes, ast.OperationTypeSubscription); err != nil {
+		return Schema{}, err
+	}
+
+	if len(directiveDefs) > 0 {
+		config.Directives = append(config.Directives, SpecifiedDirectives...)
+		for _, def := range directiveDefs {
+			directive, err := b.directive(def)
+			if err != nil {
+				return Schema{}, err
+			}
+			config.Directives = append(config.Directives, directive)
+		}
+	}
+
+	schema, err := NewSchema(config)
+	// Errors raised from inside a thunk (e.g. an unknown type reference) are
+	// more descriptive than whatever they caused NewSchema to complain about.
+	if b.err != nil {
+		return Schema{}, b.err
+	}
+	return schema, err
+}
+
+// schemaBuilder holds the state used to build runtime types from a schema
+// language document.
+type schemaBuilder struct {
+	definitions map[string]ast.Node
+	types       map[string]Type
+	extensions  []*ast.ObjectDefinition
+
+	// first error raised while evaluating a thunk
+	err error
+}
+
+func typeDefinitionName(def ast.Node) string {
+	var name *ast.Name
+	switch def := def.(type) {
+	case *ast.ScalarDefinition:
+		name = def.Name
+	case *ast.ObjectDefinition:
+		name = def.Name
+	case *ast.InterfaceDefinition:
+		name = def.Name
+	case *ast.UnionDefinition:
+		name = def.Name
+	case *ast.EnumDefinition:
+		name = def.Name
+	case *ast.InputObjectDefinition:
+		name = def.Name
+	}
+	if name == nil {
+		return ""
+	}
+	return name.Value
+}
+
+func (b *schemaBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *schemaBuilder) operationType(operationTypes map[string]string, operation string) (*Object, error) {
+	name, ok := operationTypes[operation]
+	if !ok {
+		return nil, nil
+	}
+	ttype, err := b.namedType(name)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := ttype.(*Object)
+	if !ok {
+		return nil, fmt.Errorf(`Specified %v type "%v" must be an Object type.`, operation, name)
+	}
+	return object, nil
+}
+
+// namedType returns the runtime type for the given name, building it from its
+// definition the first time it is requested.
+func (b *schemaBuilder) namedType(name string) (Type, error) {
+	if ttype, ok := b.types[name]; ok {
+		return ttype, nil
+	}
+	switch name {
+	case "String":
+		return String, nil
+	case "Int":
+		return Int, nil
+	case "Float":
+		return Float, nil
+	case "Boolean":
+		return Boolean, nil
+	case "ID":
+		return ID, nil
+	}
+	def, ok := b.definitions[name]
+	if !ok {
+		return nil, fmt.Errorf(`Type "%v" not found in document.`, name)
+	}
+
+	var ttype Type
+	switch def := def.(type) {
+	case *ast.ScalarDefinition:
+		ttype = newUntypedScalar(ScalarConfig{
+			Name:        name,
+			Description: getDescription(def),
+			Serialize:   func(value interface{}) interface{} { return value },
+			ParseValue:  func(value interface{}) interface{} { return value },
+		})
+	case *ast.ObjectDefinition:
+		ttype = NewObject(ObjectConfig{
+			Name:        name,
+			Description: getDescription(def),
+			Fields: FieldsThunk(func() Fields {
+				fields := b.fields(name, def.Fields)
+				for _, ext := range b.extensions {
+					if ext.Name.Value != name {
+						continue
+					}
+					for fieldName, field := range b.fields(name, ext.Fields) {
+						fields[fieldName] = field
+					}
+				}
+				return fields
+			}),
+			Interfaces: InterfacesThunk(func() []*Interface {
+				interfaces := b.interfaces(def.Interfaces)
+				for _, ext := range b.extensions {
+					if ext.Name.Value == name {
+						interfaces = append(interfaces, b.interfaces(ext.Interfaces)...)
+					}
+				}
+				return interfaces
+			}),
+		})
+	case *ast.InterfaceDefinition:
+		ttype = NewInterface(InterfaceConfig{
+			Name:        name,
+			Description: getDescription(def),
+			Fields: FieldsThunk(func() Fields {
+				return b.fields(name, def.Fields)
+			}),
+			ResolveType: b.resolveType,
+		})
+	case *ast.UnionDefinition:
+		ttype = NewUnion(UnionConfig{
+			Name:        name,
+			Description: getDescription(def),
+			Types: UnionTypesThunk(func() []*Object {
+				types := []*Object{}
+				for _, named := range def.Types {
+					ttype, err := b.typeFromAST(named)
+					if err != nil {
+						b.setErr(err)
+						continue
+					}
+					object, ok := ttype.(*Object)
+					if !ok {
+						b.setErr(fmt.Errorf(`Union "%v" may only contain Object types, it cannot contain: %v.`, name, ttype))
+						continue
+					}
+					types = append(types, object)
+				}
+				return types
+			}),
+			ResolveType: b.resolveType,
+		})
+	case *ast.EnumDefinition:
+		values := EnumValueConfigMap{}
+		for _, value := range def.Values {
+			if value == nil || value.Name == nil {
+				continue
+			}
+			values[value.Name.Value] = &EnumValueConfig{
+				Value:             value.Name.Value,
+				Description:       getDescription(value),
+				DeprecationReason: getDeprecationReason(value.Directives),
+			}
+		}
+		ttype = NewEnum(EnumConfig{
+			Name:        name,
+			Description: getDescription(def),
+			Values:      values,
+		})
+	case *ast.InputObjectDefinition:
+		ttype = NewInputObject(InputObjectConfig{
+			Name:        name,
+			Description: getDescription(def),
+			IsOneOf:     hasDirective(def.Directives, OneOfDirective.Name),
+			Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+				fields := InputObjectConfigFieldMap{}
+				for _, field := range def.Fields {
+					if field == nil || field.Name == nil {
+						continue
+					}
+					fieldType, defaultValue := b.inputValue(field)
+					fields[field.Name.Value] = &InputObjectFieldConfig{
+						Type:              fieldType,
+						DefaultValue:      defaultValue,
+						Description:       getDescription(field),
+						DeprecationReason: getDeprecationReason(field.Directives),
+					}
+				}
+				return fields
+			}),
+		})
+	}
+	if ttype.Error() != nil {
+		return nil, ttype.Error()
+	}
+	b.types[name] = ttype
+	return ttype, nil
+}
+
+// typeFromAST returns the runtime type for a (possibly wrapped) type reference.
+func (b *schemaBuilder) typeFromAST(typeAST ast.Type) (Type, error) {
+	switch typeAST := typeAST.(type) {
+	case *ast.List:
+		innerType, err := b.typeFromAST(typeAST.Type)
+		if err != nil {
+			return nil, err
+		}
+		return NewList(innerType), nil
+	case *ast.NonNull:
+		innerType, err := b.typeFromAST(typeAST.Type)
+		if err != nil {
+			return nil, err
+		}
+		return NewNonNull(innerType), nil
+	case *ast.Named:
+		if typeAST.Name == nil {
+			return nil, invariant(false, "Must be a named type.")
+		}
+		return b.namedType(typeAST.Name.Value)
+	}
+	return nil, invariant(false, "Must be a named type.")
+}
+
+func (b *schemaBuilder) fields(typeName string, defs []*ast.FieldDefinition) Fields {
+	fields := Fields{}
+	for _, def := range defs {
+		if def == nil || def.Name == nil {
+			continue
+		}
+		ttype, err := b.typeFromAST(def.Type)
+		if err != nil {
+			b.setErr(err)
+			continue
+		}
+		if !IsOutputType(ttype) {
+			b.setErr(fmt.Errorf(`%v.%v field type must be Output Type but got: %v.`, typeName, def.Name.Value, ttype))
+			continue
+		}
+		args := FieldConfigArgument{}
+		for _, argDef := range def.Arguments {
+			if argDef == nil || argDef.Name == nil {
+				continue
+			}
+			argType, defaultValue := b.inputValue(argDef)
+			args[argDef.Name.Value] = &ArgumentConfig{
+				Type:              argType,
+				DefaultValue:      defaultValue,
+				Description:       getDescription(argDef),
+				DeprecationReason: getDeprecationReason(argDef.Directives),
+			}
+		}
+		fields[def.Name.Value] = &Field{
+			Type:              ttype,
+			Args:              args,
+			Description:       getDescription(def),
+			DeprecationReason: getDeprecationReason(def.Directives),
+		}
+	}
+	return fields
+}
+
+func (b *schemaBuilder) interfaces(namedTypes []*ast.Named) []*Interface {
+	interfaces := []*Interface{}
+	for _, named := range namedTypes {
+		ttype, err := b.typeFromAST(named)
+		if err != nil {
+			b.setErr(err)
+			continue
+		}
+		iface, ok := ttype.(*Interface)
+		if !ok {
+			b.setErr(fmt.Errorf(`Type "%v" is not an Interface type.`, ttype))
+			continue
+		}
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces
+}
+
+// inputValue returns the input type and the coerced default value of an
+// argument or input field definition.
+func (b *schemaBuilder) inputValue(def *ast.InputValueDefinition) (Input, interface{}) {
+	ttype, err := b.typeFromAST(def.Type)
+	if err != nil {
+		b.setErr(err)
+		return nil, nil
+	}
+	if !IsInputType(ttype) {
+		b.setErr(fmt.Errorf(`Input value "%v" must be an Input Type but got: %v.`, def.Name.Value, ttype))
+		return nil, nil
+	}
+	return ttype, valueFromAST(def.DefaultValue, ttype, nil)
+}
+
+func (b *schemaBuilder) directive(def *ast.DirectiveDefinition) (*Directive, error) {
+	config := DirectiveConfig{
+		Description: getDescription(def),
+		Args:        FieldConfigArgument{},
+	}
+	if def.Name != nil {
+		config.Name = def.Name.Value
+	}
+	for _, location := range def.Locations {
+		if location != nil {
+			config.Locations = append(config.Locations, location.Value)
+		}
+	}
+	for _, argDef := range def.Arguments {
+		if argDef == nil || argDef.Name == nil {
+			continue
+		}
+		argType, defaultValue := b.inputValue(argDef)
+		if b.err != nil {
+			return nil, b.err
+		}
+		config.Args[argDef.Name.Value] = &ArgumentConfig{
+			Type:         argType,
+			DefaultValue: defaultValue,
+			Description:  getDescription(argDef),
+		}
+	}
+	directive := NewDirective(config)
+	return directive, directive.err
+}
+
+// resolveType is used by interfaces and unions built from SDL. It looks up the
+// object type named by the "__typename" property of the value.
+func (b *schemaBuilder) resolveType(p ResolveTypeParams) *Object {
+	typename, _ := DefaultResolveFn(ResolveParams{
+		Source:  p.Value,
+		Info:    ResolveInfo{FieldName: TypeNameMetaFieldDef.Name},
+		Context: p.Context,
+	})
+	name, ok := typename.(string)
+	if !ok {
+		return nil
+	}
+	object, _ := b.types[name].(*Object)
+	return object
+}
+
+func getDescription(node ast.DescribableNode) string {
+	if desc := node.GetDescription(); desc != nil {
+		return desc.Value
+	}
+	return ""
+}
+
+// getDeprecationReason returns the reason given to a @deprecated directive,
+// or an empty string if the element is not deprecated.
+func getDeprecationReason(directives []*ast.Directive) string {
+	for _, directive := range directives {
+		if directive == nil || directive.Name == nil || directive.Name.Value != DeprecatedDirective.Name {
+			continue
+		}
+		args := getArgumentValues(DeprecatedDirective.Args, directive.Arguments, nil)
+		if reason, ok := args["reason"].(string); ok {
+			return reason
+		}
+		return DefaultDeprecationReason
+	}
+	return ""
+}
+
+// hasDirective returns true if the directive of the given name is applied.
+func hasDirective(directives []*ast.Directive, name string) bool {
+	for _, directive := range directives {
+		if directive != nil && directive.Name != nil && directive.Name.Value == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,400 @@
+package graphql_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+const buildSchemaTestSDL = `
+schema {
+  query: Root
+}
+
+"""
+A character in the films
+"""
+interface Character {
+  name: String
+  friends: [Character]
+}
+
+type Human implements Character {
+  name: String
+  friends: [Character]
+  homePlanet: String
+}
+
+type Droid implements Character {
+  name: String
+  friends: [Character]
+  primaryFunction: String @deprecated(reason: "Use function")
+}
+
+union SearchResult = Human | Droid
+
+enum Episode {
+  NEWHOPE
+  EMPIRE
+  JEDI @deprecated
+}
+
+input Filter {
+  episode: Episode = EMPIRE
+  limit: Int = 10
+}
+
+type Root {
+  hero(episode: Episode): Character
+  search(filter: Filter): [SearchResult]
+  echo(filter: Filter): String
+}
+`
+
+func TestBuildSchema_BuildsTypesFromSDL(t *testing.T) {
+	schema, err := graphql.BuildSchema(buildSchemaTestSDL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.QueryType().Name() != "Root" {
+		t.Fatalf("expected query type Root, got %v", schema.QueryType())
+	}
+	for _, name := range []string{"Character", "Human", "Droid", "SearchResult", "Episode", "Filter"} {
+		if schema.Type(name) == nil {
+			t.Fatalf("expected type %v to be in the schema", name)
+		}
+	}
+	human, ok := schema.Type("Human").(*graphql.Object)
+	if !ok {
+		t.Fatalf("expected Human to be an object type")
+	}
+	if len(human.Interfaces()) != 1 || human.Interfaces()[0].Name() != "Character" {
+		t.Fatalf("expected Human to implement Character, got %v", human.Interfaces())
+	}
+	droid := schema.Type("Droid").(*graphql.Object)
+	if reason := droid.Fields()["primaryFunction"].DeprecationReason; reason != "Use function" {
+		t.Fatalf("unexpected deprecation reason: %q", reason)
+	}
+	if desc := schema.Type("Character").Description(); desc != "A character in the films" {
+		t.Fatalf("unexpected description: %q", desc)
+	}
+	episode := schema.Type("Episode").(*graphql.Enum)
+	for _, value := range episode.Values() {
+		if value.Name == "JEDI" && value.DeprecationReason != graphql.DefaultDeprecationReason {
+			t.Fatalf("expected JEDI to use the default deprecation reason, got %q", value.DeprecationReason)
+		}
+	}
+}
+
+func TestBuildSchema_ExecutesWithDefaultResolvers(t *testing.T) {
+	schema, err := graphql.BuildSchema(buildSchemaTestSDL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	luke := map[string]interface{}{
+		"__typename": "Human",
+		"name":       "Luke",
+		"homePlanet": "Tatooine",
+	}
+	r2 := map[string]interface{}{
+		"__typename": "Droid",
+		"name":       "R2-D2",
+		"friends":    []interface{}{luke},
+	}
+	root := map[string]interface{}{
+		"hero":   r2,
+		"search": []interface{}{luke, r2},
+	}
+	query := `
+		{
+			hero {
+				name
+				friends {
+					name
+					... on Human {
+						homePlanet
+					}
+				}
+			}
+			search {
+				__typename
+				... on Droid {
+					name
+				}
+			}
+		}
+	`
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"hero": map[string]interface{}{
+				"name": "R2-D2",
+				"friends": []interface{}{
+					map[string]interface{}{
+						"name":       "Luke",
+						"homePlanet": "Tatooine",
+					},
+				},
+			},
+			"search": []interface{}{
+				map[string]interface{}{
+					"__typename": "Human",
+				},
+				map[string]interface{}{
+					"__typename": "Droid",
+					"name":       "R2-D2",
+				},
+			},
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		RootObject:    root,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_AppliesArgumentDefaults(t *testing.T) {
+	schema, err := graphql.BuildSchema(buildSchemaTestSDL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	echo := schema.QueryType().Fields()["echo"]
+	echo.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+		filter := p.Args["filter"].(map[string]interface{})
+		return filter["episode"].(string) + ":" + strings.Repeat("*", filter["limit"].(int)), nil
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ echo(filter: {limit: 3}) }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"echo": "EMPIRE:***",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_ResolvesFieldsAddedByTypeExtensions(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		type Query {
+			hero: Human
+		}
+		type Human {
+			name: String
+		}
+		extend type Query {
+			greeting(name: String = "world"): String
+		}
+		extend type Human {
+			shout: String
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	schema.QueryType().Fields()["greeting"].Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+		return "Hello, " + p.Args["name"].(string) + "!", nil
+	}
+	human := schema.Type("Human").(*graphql.Object)
+	human.Fields()["shout"].Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+		name := p.Source.(map[string]interface{})["name"].(string)
+		return strings.ToUpper(name), nil
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ greeting hi: greeting(name: "Leia") hero { name shout } }`,
+		RootObject: map[string]interface{}{
+			"hero": map[string]interface{}{"name": "Luke"},
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"greeting": "Hello, world!",
+			"hi":       "Hello, Leia!",
+			"hero": map[string]interface{}{
+				"name":  "Luke",
+				"shout": "LUKE",
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_DefaultsToQueryTypeName(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		type Query {
+			self: Query
+			name: String
+		}
+		type Mutation {
+			rename(name: String!): String
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.QueryType().Name() != "Query" {
+		t.Fatalf("expected query type Query, got %v", schema.QueryType())
+	}
+	if schema.MutationType() == nil || schema.MutationType().Name() != "Mutation" {
+		t.Fatalf("expected mutation type Mutation, got %v", schema.MutationType())
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ self { self { name } } }`,
+		RootObject: map[string]interface{}{
+			"self": map[string]interface{}{
+				"self": map[string]interface{}{
+					"name": "nested",
+				},
+			},
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"self": map[string]interface{}{
+				"self": map[string]interface{}{
+					"name": "nested",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_CustomDirectivesAndScalars(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		scalar Date
+
+		directive @auth(role: String = "admin") on FIELD_DEFINITION
+
+		type Query {
+			today: Date
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.Directive("auth") == nil {
+		t.Fatalf("expected @auth directive in schema")
+	}
+	if schema.Directive("include") == nil || schema.Directive("skip") == nil {
+		t.Fatalf("expected specified directives to be kept")
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ today }`,
+		RootObject: map[string]interface{}{
+			"today": "2017-01-01",
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"today": "2017-01-01",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_OneOfInputObjects(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		input UserBy @oneOf {
+		  email: String
+		  id: ID
+		}
+
+		type Query {
+		  user(by: UserBy): String
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !schema.Type("UserBy").(*graphql.InputObject).IsOneOf() {
+		t.Fatalf("expected UserBy to be a OneOf input object")
+	}
+	expectedInput := "input UserBy @oneOf {\n  email: String\n  id: ID\n}"
+	if printed := graphql.PrintSchema(schema); !strings.Contains(printed, expectedInput) {
+		t.Fatalf("expected the printed schema to contain %q, got %q", expectedInput, printed)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+			userBy: __type(name: "UserBy") { isOneOf }
+			query: __type(name: "Query") { isOneOf }
+		}`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"userBy": map[string]interface{}{"isOneOf": true},
+			"query":  map[string]interface{}{"isOneOf": nil},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestBuildSchema_RejectsInvalidDocuments(t *testing.T) {
+	tests := []struct {
+		sdl      string
+		expected string
+	}{
+		{
+			sdl:      `type Query { hero: Character }`,
+			expected: `Type "Character" not found in document.`,
+		},
+		{
+			sdl:      `type Query { name: String } type Query { id: ID }`,
+			expected: `Type "Query" was defined more than once.`,
+		},
+		{
+			sdl:      `schema { query: Episode } enum Episode { JEDI }`,
+			expected: `Specified query type "Episode" must be an Object type.`,
+		},
+		{
+			sdl:      `type Query { name: String } query { name }`,
+			expected: `Cannot build a schema from a document containing a OperationDefinition.`,
+		},
+		{
+			sdl:      `type Query { node: Node } interface Node { id: ID! name: String } type User implements Node { name: String }`,
+			expected: `"Node" expects field "id" but "User" does not provide it.`,
+		},
+		{
+			sdl:      `type Query { node: Node } interface Node { id: ID! name: String } type User implements Node { id: ID name: String }`,
+			expected: `Node.id expects type "ID!" but User.id provides type "ID".`,
+		},
+		{
+			sdl:      `type Query { node: Node } interface Node { id(short: Boolean): ID! } type User implements Node { id: ID! }`,
+			expected: `Node.id expects argument "short" but User.id does not provide it.`,
+		},
+		{
+			sdl:      `type Query { node: Node } interface Node { name: String id: ID! } type User implements Node { other: String }`,
+			expected: `"Node" expects field "id" but "User" does not provide it.`,
+		},
+	}
+	for _, test := range tests {
+		_, err := graphql.BuildSchema(test.sdl)
+		if err == nil {
+			t.Fatalf("expected error for %v", test.sdl)
+		}
+		if err.Error() != test.expected {
+			t.Fatalf("expected error %q, got %q", test.expected, err.Error())
+		}
+	}
+}
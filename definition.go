@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 
 	"github.com/graphql-go/graphql/language/ast"
 )
@@ -206,6 +207,10 @@ type Scalar struct {
 
 	scalarConfig ScalarConfig
 	err          error
+
+	// untypedLiterals is set for scalars taking literals as the untyped values
+	// they represent, which may hold variables.
+	untypedLiterals bool
 }
 
 // SerializeFn is a function type for serializing a GraphQLScalar type value
@@ -286,6 +291,26 @@ func (st *Scalar) ParseLiteral(valueAST ast.Value) interface{} {
 	}
 	return st.scalarConfig.ParseLiteral(valueAST)
 }
+
+// newUntypedScalar creates a scalar taking literals as the untyped values they
+// represent, such as a map for an object.
+func newUntypedScalar(config ScalarConfig) *Scalar {
+	config.ParseLiteral = func(valueAST ast.Value) interface{} {
+		return valueFromASTUntyped(valueAST, nil)
+	}
+	st := NewScalar(config)
+	st.untypedLiterals = true
+	return st
+}
+
+// parseLiteral parses a literal within an operation, resolving the variables
+// it holds for scalars taking untyped literals.
+func (st *Scalar) parseLiteral(valueAST ast.Value, variables map[string]interface{}) interface{} {
+	if st.untypedLiterals {
+		return valueFromASTUntyped(valueAST, variables)
+	}
+	return st.ParseLiteral(valueAST)
+}
 func (st *Scalar) Name() string {
 	return st.PrivateName
 }
@@ -419,7 +444,7 @@ func (gt *Object) Name() string {
 	return gt.PrivateName
 }
 func (gt *Object) Description() string {
-	return ""
+	return gt.PrivateDescription
 }
 func (gt *Object) String() string {
 	return gt.PrivateName
@@ -483,18 +508,6 @@ func defineInterfaces(ttype *Object, interfaces []*Interface) ([]*Interface, err
 		if err != nil {
 			return ifaces, err
 		}
-		if iface.ResolveType != nil {
-			err = invariantf(
-				iface.ResolveType != nil,
-				`Interface Type %v does not provide a "resolveType" function `+
-					`and implementing Type %v does not provide a "isTypeOf" `+
-					`function. There is no way to resolve this implementing type `+
-					`during execution.`, iface, ttype,
-			)
-			if err != nil {
-				return ifaces, err
-			}
-		}
 		ifaces = append(ifaces, iface)
 	}
 
@@ -535,6 +548,7 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 			Type:              field.Type,
 			Resolve:           field.Resolve,
 			Subscribe:         field.Subscribe,
+			CoerceArgs:        field.CoerceArgs,
 			DeprecationReason: field.DeprecationReason,
 		}
 
@@ -560,6 +574,7 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 				PrivateDescription: arg.Description,
 				Type:               arg.Type,
 				DefaultValue:       arg.DefaultValue,
+				DeprecationReason:  arg.DeprecationReason,
 			}
 			fieldDef.Args = append(fieldDef.Args, fieldArg)
 		}
@@ -587,6 +602,12 @@ type ResolveParams struct {
 
 type FieldResolveFn func(p ResolveParams) (interface{}, error)
 
+// FieldCoerceArgsFn is given the arguments of a field once they are coerced
+// to their types, and returns the arguments passed to its resolver. It may
+// apply validation the types can not express, e.g. constraints between
+// arguments, by returning an error.
+type FieldCoerceArgsFn func(args map[string]interface{}) (map[string]interface{}, error)
+
 type ResolveInfo struct {
 	FieldName      string
 	FieldASTs      []*ast.Field
@@ -608,6 +629,7 @@ type Field struct {
 	Args              FieldConfigArgument `json:"args"`
 	Resolve           FieldResolveFn      `json:"-"`
 	Subscribe         FieldResolveFn      `json:"-"`
+	CoerceArgs        FieldCoerceArgsFn   `json:"-"`
 	DeprecationReason string              `json:"deprecationReason"`
 	Description       string              `json:"description"`
 }
@@ -615,20 +637,22 @@ type Field struct {
 type FieldConfigArgument map[string]*ArgumentConfig
 
 type ArgumentConfig struct {
-	Type         Input       `json:"type"`
-	DefaultValue interface{} `json:"defaultValue"`
-	Description  string      `json:"description"`
+	Type              Input       `json:"type"`
+	DefaultValue      interface{} `json:"defaultValue"`
+	Description       string      `json:"description"`
+	DeprecationReason string      `json:"deprecationReason"`
 }
 
 type FieldDefinitionMap map[string]*FieldDefinition
 type FieldDefinition struct {
-	Name              string         `json:"name"`
-	Description       string         `json:"description"`
-	Type              Output         `json:"type"`
-	Args              []*Argument    `json:"args"`
-	Resolve           FieldResolveFn `json:"-"`
-	Subscribe         FieldResolveFn `json:"-"`
-	DeprecationReason string         `json:"deprecationReason"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	Type              Output            `json:"type"`
+	Args              []*Argument       `json:"args"`
+	Resolve           FieldResolveFn    `json:"-"`
+	Subscribe         FieldResolveFn    `json:"-"`
+	CoerceArgs        FieldCoerceArgsFn `json:"-"`
+	DeprecationReason string            `json:"deprecationReason"`
 }
 
 type FieldArgument struct {
@@ -643,6 +667,7 @@ type Argument struct {
 	Type               Input       `json:"type"`
 	DefaultValue       interface{} `json:"defaultValue"`
 	PrivateDescription string      `json:"description"`
+	DeprecationReason  string      `json:"deprecationReason"`
 }
 
 func (st *Argument) Name() string {
@@ -871,17 +896,6 @@ func defineUnionTypes(objectType *Union, unionTypes []*Object) ([]*Object, error
 		); err != nil {
 			return definedUnionTypes, err
 		}
-		if objectType.ResolveType == nil {
-			if err := invariantf(
-				ttype.IsTypeOf != nil,
-				`Union Type %v does not provide a "resolveType" function `+
-					`and possible Type %v does not provide a "isTypeOf" `+
-					`function. There is no way to resolve this possible type `+
-					`during execution.`, objectType, ttype,
-			); err != nil {
-				return definedUnionTypes, err
-			}
-		}
 		definedUnionTypes = append(definedUnionTypes, ttype)
 	}
 
@@ -1002,6 +1016,11 @@ func (gt *Enum) defineEnumValues(valueMap EnumValueConfigMap) ([]*EnumValueDefin
 		}
 		values = append(values, value)
 	}
+	// the values are configured in a map, sort them to list them in the
+	// same order every time
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
 	return values, nil
 }
 func (gt *Enum) Values() []*EnumValueDefinition {
@@ -1107,15 +1126,17 @@ type InputObject struct {
 	err        error
 }
 type InputObjectFieldConfig struct {
-	Type         Input       `json:"type"`
-	DefaultValue interface{} `json:"defaultValue"`
-	Description  string      `json:"description"`
+	Type              Input       `json:"type"`
+	DefaultValue      interface{} `json:"defaultValue"`
+	Description       string      `json:"description"`
+	DeprecationReason string      `json:"deprecationReason"`
 }
 type InputObjectField struct {
 	PrivateName        string      `json:"name"`
 	Type               Input       `json:"type"`
 	DefaultValue       interface{} `json:"defaultValue"`
 	PrivateDescription string      `json:"description"`
+	DeprecationReason  string      `json:"deprecationReason"`
 }
 
 func (st *InputObjectField) Name() string {
@@ -1138,6 +1159,12 @@ type InputObjectConfig struct {
 	Name        string      `json:"name"`
 	Fields      interface{} `json:"fields"`
 	Description string      `json:"description"`
+
+	// IsOneOf makes the input object a OneOf input object, of which exactly
+	// one field must be given, e.g. to accept one of several variants of an
+	// argument. The fields of a OneOf input object must all be nullable, and
+	// have no default value.
+	IsOneOf bool `json:"isOneOf"`
 }
 
 func NewInputObject(config InputObjectConfig) *InputObject {
@@ -1185,11 +1212,27 @@ func (gt *InputObject) defineFieldMap() InputObjectFieldMap {
 		); gt.err != nil {
 			return resultFieldMap
 		}
+		if gt.typeConfig.IsOneOf {
+			_, isNonNull := fieldConfig.Type.(*NonNull)
+			if gt.err = invariantf(
+				!isNonNull,
+				`OneOf input field %v.%v must be nullable.`, gt, fieldName,
+			); gt.err != nil {
+				return resultFieldMap
+			}
+			if gt.err = invariantf(
+				fieldConfig.DefaultValue == nil,
+				`OneOf input field %v.%v cannot have a default value.`, gt, fieldName,
+			); gt.err != nil {
+				return resultFieldMap
+			}
+		}
 		field := &InputObjectField{}
 		field.PrivateName = fieldName
 		field.Type = fieldConfig.Type
 		field.PrivateDescription = fieldConfig.Description
 		field.DefaultValue = fieldConfig.DefaultValue
+		field.DeprecationReason = fieldConfig.DeprecationReason
 		resultFieldMap[fieldName] = field
 	}
 	gt.init = true
@@ -1227,6 +1270,11 @@ func (gt *InputObject) Error() error {
 	return gt.err
 }
 
+// IsOneOf returns true if exactly one field of the input object must be given.
+func (gt *InputObject) IsOneOf() bool {
+	return gt.typeConfig.IsOneOf
+}
+
 // List Modifier
 //
 // A list is a kind of type marker, a wrapping type which points to another
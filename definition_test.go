@@ -333,6 +333,73 @@ func TestTypeSystem_DefinitionExample_IncludesNestedInputObjectsInTheMap(t *test
 	}
 }
 
+func TestTypeSystem_DefinitionExample_IncludesDeeplyNestedTypesInTheTypeMap(t *testing.T) {
+	buriedType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Buried",
+		Values: graphql.EnumValueConfigMap{
+			"TREASURE": &graphql.EnumValueConfig{},
+		},
+	})
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"buried": &graphql.InputObjectFieldConfig{Type: buriedType},
+		},
+	})
+	levelType := func(name string, fieldType graphql.Output) *graphql.Object {
+		return graphql.NewObject(graphql.ObjectConfig{
+			Name: name,
+			Fields: graphql.Fields{
+				"next": &graphql.Field{Type: fieldType},
+			},
+		})
+	}
+	tagDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "tag",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			"label": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewScalar(graphql.ScalarConfig{
+					Name: "Label",
+					Serialize: func(value interface{}) interface{} {
+						return value
+					},
+				})),
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"first": &graphql.Field{
+					Type: levelType("First", levelType("Second", graphql.NewList(levelType("Third", buriedType)))),
+				},
+				"search": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"filter": &graphql.ArgumentConfig{Type: filterType},
+					},
+				},
+			},
+		}),
+		Directives: append([]*graphql.Directive{tagDirective}, graphql.SpecifiedDirectives...),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error, got: %v", err)
+	}
+
+	typeMap := schema.TypeMap()
+	for _, name := range []string{"First", "Second", "Third", "Buried", "Filter", "Label", "__Schema", "__Type"} {
+		if _, ok := typeMap[name]; !ok {
+			t.Fatalf("expected the type map to include %v, got: %v", name, typeMap)
+		}
+	}
+	if typeMap["Buried"] != buriedType {
+		t.Fatalf(`expected the type map to include buriedType, got: %v`, typeMap["Buried"])
+	}
+}
+
 func TestTypeSystem_DefinitionExample_IncludesInterfacesSubTypesInTheTypeMap(t *testing.T) {
 
 	someInterface := graphql.NewInterface(graphql.InterfaceConfig{
@@ -419,6 +486,61 @@ func TestTypeSystem_DefinitionExample_IncludesInterfacesThunkSubtypesInTheTypeMa
 	}
 }
 
+func TestTypeSystem_DefinitionExample_ReportsImplementedInterfaces(t *testing.T) {
+
+	namedInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Named",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	agedInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Aged",
+		Fields: graphql.Fields{
+			"age": &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+	})
+	personType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Person",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"age": &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+		Interfaces: []*graphql.Interface{namedInterface, agedInterface},
+	})
+	petType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+		Interfaces: (graphql.InterfacesThunk)(func() []*graphql.Interface {
+			return []*graphql.Interface{namedInterface}
+		}),
+	})
+
+	expected := []*graphql.Interface{namedInterface, agedInterface}
+	if !reflect.DeepEqual(personType.Interfaces(), expected) {
+		t.Fatalf("Unexpected interfaces of Person, Diff: %v", testutil.Diff(expected, personType.Interfaces()))
+	}
+	expected = []*graphql.Interface{namedInterface}
+	if !reflect.DeepEqual(petType.Interfaces(), expected) {
+		t.Fatalf("Unexpected interfaces of Pet, Diff: %v", testutil.Diff(expected, petType.Interfaces()))
+	}
+	if interfaces := blogImage.Interfaces(); len(interfaces) != 0 {
+		t.Fatalf("expected Image to implement no interfaces, got: %v", interfaces)
+	}
+}
+
 func TestTypeSystem_DefinitionExample_StringifiesSimpleTypes(t *testing.T) {
 
 	type Test struct {
@@ -446,6 +568,36 @@ func TestTypeSystem_DefinitionExample_StringifiesSimpleTypes(t *testing.T) {
 	}
 }
 
+func TestTypeSystem_DefinitionExample_ReportsTypeDescriptions(t *testing.T) {
+	objectType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Described",
+		Description: "An object with a description.",
+		Fields: graphql.Fields{
+			"field": &graphql.Field{Type: graphql.String},
+		},
+	})
+	interfaceType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        "DescribedInterface",
+		Description: "An interface with a description.",
+		Fields: graphql.Fields{
+			"field": &graphql.Field{Type: graphql.String},
+		},
+	})
+	tests := []struct {
+		ttype    graphql.Type
+		expected string
+	}{
+		{objectType, "An object with a description."},
+		{interfaceType, "An interface with a description."},
+		{blogArticle, ""},
+	}
+	for _, test := range tests {
+		if description := test.ttype.Description(); description != test.expected {
+			t.Fatalf(`expected description of %v to be %q, got: %q`, test.ttype, test.expected, description)
+		}
+	}
+}
+
 func TestTypeSystem_DefinitionExample_IdentifiesInputTypes(t *testing.T) {
 	type Test struct {
 		ttype    graphql.Type
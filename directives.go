@@ -1,5 +1,7 @@
 package graphql
 
+import "context"
+
 const (
 	// Operations
 	DirectiveLocationQuery              = "QUERY"
@@ -32,6 +34,7 @@ var SpecifiedDirectives = []*Directive{
 	IncludeDirective,
 	SkipDirective,
 	DeprecatedDirective,
+	OneOfDirective,
 }
 
 // Directive structs are used by the GraphQL runtime as a way of modifying execution
@@ -42,15 +45,40 @@ type Directive struct {
 	Locations   []string    `json:"locations"`
 	Args        []*Argument `json:"args"`
 
+	// Resolve, if set, is called with the resolved value of every field the
+	// directive is applied to, and returns the value to complete instead.
+	Resolve DirectiveResolveFn `json:"-"`
+
 	err error
 }
 
+// DirectiveResolveFn transforms the resolved value of a field a directive is
+// applied to.
+type DirectiveResolveFn func(p DirectiveResolveParams) (interface{}, error)
+
+// DirectiveResolveParams Params for DirectiveResolveFn()
+type DirectiveResolveParams struct {
+	// Value is the value returned by the field's resolver, or by the thunk
+	// it returned, or by the previous directive applied to the field.
+	Value interface{}
+
+	// Args is the set of arguments passed to the directive.
+	Args map[string]interface{}
+
+	// Info is the ResolveInfo of the field the directive is applied to.
+	Info ResolveInfo
+
+	// Context argument is a context value that is provided to every resolve function within an execution.
+	Context context.Context
+}
+
 // DirectiveConfig options for creating a new GraphQLDirective
 type DirectiveConfig struct {
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
 	Locations   []string            `json:"locations"`
 	Args        FieldConfigArgument `json:"args"`
+	Resolve     DirectiveResolveFn  `json:"-"`
 }
 
 func NewDirective(config DirectiveConfig) *Directive {
@@ -82,6 +110,7 @@ func NewDirective(config DirectiveConfig) *Directive {
 			PrivateDescription: argConfig.Description,
 			Type:               argConfig.Type,
 			DefaultValue:       argConfig.DefaultValue,
+			DeprecationReason:  argConfig.DeprecationReason,
 		})
 	}
 
@@ -89,6 +118,7 @@ func NewDirective(config DirectiveConfig) *Directive {
 	dir.Description = config.Description
 	dir.Locations = config.Locations
 	dir.Args = args
+	dir.Resolve = config.Resolve
 	return dir
 }
 
@@ -143,6 +173,18 @@ var DeprecatedDirective = NewDirective(DirectiveConfig{
 	},
 	Locations: []string{
 		DirectiveLocationFieldDefinition,
+		DirectiveLocationArgumentDefinition,
+		DirectiveLocationInputFieldDefinition,
 		DirectiveLocationEnumValue,
 	},
 })
+
+// OneOfDirective Used to declare an input object as a OneOf input object, of
+// which exactly one field must be given.
+var OneOfDirective = NewDirective(DirectiveConfig{
+	Name:        "oneOf",
+	Description: "Indicates exactly one field must be supplied and this field must not be `null`.",
+	Locations: []string{
+		DirectiveLocationInputObject,
+	},
+})
@@ -1,11 +1,15 @@
 package graphql_test
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -514,3 +518,340 @@ func TestDirectivesWorksWithSkipAndIncludeDirectives_NoIncludeOrSkip(t *testing.
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestDirectivesWorksWithSkipAndIncludeDirectives_ConflictingVariablesOnFieldsAndFragments(t *testing.T) {
+	query := `
+      query Q($skip: Boolean!, $include: Boolean!) {
+        a @skip(if: $skip) @include(if: $include)
+        ...Frag @include(if: $include) @skip(if: $skip)
+      }
+      fragment Frag on TestType { b }
+    `
+	for _, tc := range []struct {
+		skip, include bool
+		included      bool
+	}{
+		{skip: false, include: true, included: true},
+		{skip: true, include: true, included: false},
+		{skip: false, include: false, included: false},
+		{skip: true, include: false, included: false},
+	} {
+		expected := &graphql.Result{
+			Data: map[string]interface{}{},
+		}
+		if tc.included {
+			expected.Data = map[string]interface{}{
+				"a": "a",
+				"b": "b",
+			}
+		}
+		result := testutil.TestExecute(t, graphql.ExecuteParams{
+			Schema: directivesTestSchema,
+			AST:    testutil.TestParse(t, query),
+			Root:   directivesTestData,
+			Args: map[string]interface{}{
+				"skip":    tc.skip,
+				"include": tc.include,
+			},
+		})
+		if !testutil.EqualResults(expected, result) {
+			t.Fatalf("skip: %v, include: %v: Unexpected result, Diff: %v", tc.skip, tc.include, testutil.Diff(expected, result))
+		}
+	}
+}
+
+func TestDirectivesWorksWithBooleanVariables(t *testing.T) {
+	query := `
+		query Q($include: Boolean!, $skip: Boolean!) {
+			a @include(if: $include)
+			...Frag @skip(if: $skip)
+			... on TestType @include(if: $include) @skip(if: $skip) {
+				inline: a
+			}
+		}
+		fragment Frag on TestType {
+			b
+		}
+	`
+	tests := []struct {
+		variables map[string]interface{}
+		expected  map[string]interface{}
+	}{
+		{
+			variables: map[string]interface{}{"include": true, "skip": false},
+			expected: map[string]interface{}{
+				"a":      "a",
+				"b":      "b",
+				"inline": "a",
+			},
+		},
+		{
+			variables: map[string]interface{}{"include": false, "skip": false},
+			expected: map[string]interface{}{
+				"b": "b",
+			},
+		},
+		{
+			variables: map[string]interface{}{"include": true, "skip": true},
+			expected: map[string]interface{}{
+				"a": "a",
+			},
+		},
+		{
+			variables: map[string]interface{}{"include": false, "skip": true},
+			expected:  map[string]interface{}{},
+		},
+	}
+	for _, test := range tests {
+		ep := graphql.ExecuteParams{
+			Schema: directivesTestSchema,
+			AST:    testutil.TestParse(t, query),
+			Root:   directivesTestData,
+			Args:   test.variables,
+		}
+		result := testutil.TestExecute(t, ep)
+		expected := &graphql.Result{
+			Data: test.expected,
+		}
+		if !testutil.EqualResults(expected, result) {
+			t.Fatalf("Unexpected result for %v, Diff: %v", test.variables, testutil.Diff(expected, result))
+		}
+	}
+}
+
+func TestDirectivesUserDefinedDirectiveTransformsResolvedValue(t *testing.T) {
+	upperDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:        "upper",
+		Description: "Uppercases the string value of a field.",
+		Locations:   []string{graphql.DirectiveLocationField},
+		Resolve: func(p graphql.DirectiveResolveParams) (interface{}, error) {
+			if s, ok := p.Value.(string); ok {
+				return strings.ToUpper(s), nil
+			}
+			return p.Value, nil
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "TestType",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "hello", nil
+					},
+				},
+			},
+		}),
+		Directives: append(graphql.SpecifiedDirectives, upperDirective),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	query := `{
+		greeting
+		loud: greeting @upper
+		__schema {
+			directives {
+				name
+			}
+		}
+	}`
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"greeting": "hello",
+			"loud":     "HELLO",
+			"__schema": map[string]interface{}{
+				"directives": []interface{}{
+					map[string]interface{}{"name": "include"},
+					map[string]interface{}{"name": "skip"},
+					map[string]interface{}{"name": "deprecated"},
+					map[string]interface{}{"name": "oneOf"},
+					map[string]interface{}{"name": "upper"},
+				},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestDirectivesUserDefinedDirectiveTransformsThunksAndMergedFields(t *testing.T) {
+	upperDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "upper",
+		Locations: []string{graphql.DirectiveLocationField},
+		Resolve: func(p graphql.DirectiveResolveParams) (interface{}, error) {
+			if s, ok := p.Value.(string); ok {
+				return strings.ToUpper(s), nil
+			}
+			return p.Value, nil
+		},
+	})
+	exclaimDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "exclaim",
+		Locations: []string{graphql.DirectiveLocationField},
+		Resolve: func(p graphql.DirectiveResolveParams) (interface{}, error) {
+			if s, ok := p.Value.(string); ok {
+				return s + "!", nil
+			}
+			return p.Value, nil
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "TestType",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "hello", nil
+					},
+				},
+				"thunk": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return func() (interface{}, error) {
+							return "hello", nil
+						}, nil
+					},
+				},
+				"contextThunk": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return func(ctx context.Context) (interface{}, error) {
+							return "hello", nil
+						}, nil
+					},
+				},
+			},
+		}),
+		Directives: append(graphql.SpecifiedDirectives, upperDirective, exclaimDirective),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	query := `
+		query {
+			thunk @upper
+			contextThunk @upper @exclaim
+			merged: greeting
+			... on TestType {
+				merged: greeting @upper
+			}
+			twice: greeting @exclaim
+			...Twice
+		}
+		fragment Twice on TestType {
+			twice: greeting @exclaim
+		}
+	`
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"thunk":        "HELLO",
+			"contextThunk": "HELLO!",
+			"merged":       "HELLO",
+			"twice":        "hello!",
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestDirectivesUserDefinedDirectivesAreAppliedInOrder(t *testing.T) {
+	var applied []string
+	upperDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "upper",
+		Locations: []string{graphql.DirectiveLocationField},
+		Resolve: func(p graphql.DirectiveResolveParams) (interface{}, error) {
+			applied = append(applied, "upper")
+			return strings.ToUpper(p.Value.(string)), nil
+		},
+	})
+	truncateDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "truncate",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			"length": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+		},
+		Resolve: func(p graphql.DirectiveResolveParams) (interface{}, error) {
+			applied = append(applied, "truncate")
+			s := p.Value.(string)
+			length := p.Args["length"].(int)
+			if length < 0 {
+				return nil, errors.New("length must not be negative")
+			}
+			if len(s) > length {
+				return s[:length] + "...", nil
+			}
+			return s, nil
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "TestType",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "hello world", nil
+					},
+				},
+			},
+		}),
+		Directives: append(graphql.SpecifiedDirectives, upperDirective, truncateDirective),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($length: Int!) { greeting @upper @truncate(length: $length) }`,
+		VariableValues: map[string]interface{}{"length": 5},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"greeting": "HELLO...",
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if expectedApplied := []string{"upper", "truncate"}; !reflect.DeepEqual(expectedApplied, applied) {
+		t.Fatalf("Unexpected directive order, Diff: %v", testutil.Diff(expectedApplied, applied))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ greeting @upper @truncate(length: -1) }`,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"greeting": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: "length must not be negative",
+				Locations: []location.SourceLocation{
+					{Line: 1, Column: 3},
+				},
+				Path: []interface{}{"greeting"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
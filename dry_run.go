@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// dryRun returns the shape of the response to the operation without calling
+// any resolver. Each field is present under its response name, objects are
+// maps of the fields selected on them whatever their type, and leaf values
+// are null. Lists are not expanded, they have the shape of their items.
+// Operations exceeding the MaxRootFields or MaxFragmentDepth of the execution
+// are rejected as they would be when executed.
+func dryRun(p buildExecutionCtxParams) (result *Result) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				panic(r)
+			}
+			result = &Result{Errors: gqlerrors.FormatErrors(err)}
+		}
+	}()
+
+	eCtx, err := buildExecutionContext(p)
+	if err != nil {
+		return &Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	operationType, err := getOperationRootType(eCtx.Schema, eCtx.Operation)
+	if err != nil {
+		return &Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	fields := collectFields(collectFieldsParams{
+		ExeContext:   eCtx,
+		RuntimeType:  operationType,
+		SelectionSet: eCtx.Operation.GetSelectionSet(),
+	})
+	if err := checkMaxRootFields(eCtx, fields); err != nil {
+		return &Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	shape := map[string]interface{}{}
+	collectResponseShape(eCtx, eCtx.Operation.GetSelectionSet(), shape, map[string]bool{})
+	return &Result{Data: shape}
+}
+
+// collectResponseShape adds the fields of the selection set to shape, merging
+// the selections of fields with the same response name. The fragments being
+// expanded are tracked in visiting to stop on fragment cycles.
+func collectResponseShape(eCtx *executionContext, selectionSet *ast.SelectionSet, shape map[string]interface{}, visiting map[string]bool) {
+	walkSelectedFields(eCtx, selectionSet, 0, visiting, func(field *ast.Field) {
+		name := getFieldEntryKey(field)
+		if field.SelectionSet == nil {
+			shape[name] = nil
+			return
+		}
+		fieldShape, ok := shape[name].(map[string]interface{})
+		if !ok {
+			fieldShape = map[string]interface{}{}
+			shape[name] = fieldShape
+		}
+		collectResponseShape(eCtx, field.SelectionSet, fieldShape, visiting)
+	})
+}
@@ -257,6 +257,70 @@ func TestTypeSystem_EnumValues_AcceptsJSONStringAsEnumVariable(t *testing.T) {
 	}
 }
 
+func TestTypeSystem_EnumValues_CoercesEnumNameVariablesToInternalValues(t *testing.T) {
+	query := `query test($color: Color!) { colorInt(fromEnum: $color) }`
+	params := map[string]interface{}{
+		"color": "BLUE",
+	}
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"colorInt": 2,
+		},
+	}
+	result := executeEnumTypeTestWithParams(t, query, params)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	// enum names nested in input objects and lists are coerced the same way.
+	paletteType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Palette",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"colors": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewList(enumTypeTestColorType),
+			},
+		},
+	})
+	var colors interface{}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"paint": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						"palette": &graphql.ArgumentConfig{
+							Type: paletteType,
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						colors = p.Args["palette"].(map[string]interface{})["colors"]
+						return true, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	result = g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: `query test($palette: Palette) { paint(palette: $palette) }`,
+		VariableValues: map[string]interface{}{
+			"palette": map[string]interface{}{
+				"colors": []interface{}{"GREEN", "RED"},
+			},
+		},
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	if expected := []interface{}{1, 0}; !reflect.DeepEqual(expected, colors) {
+		t.Fatalf("Unexpected colors, Diff: %v", testutil.Diff(expected, colors))
+	}
+}
+
 func TestTypeSystem_EnumValues_AcceptsEnumLiteralsAsInputArgumentsToMutations(t *testing.T) {
 	query := `mutation x($color: Color!) { favoriteEnum(color: $color) }`
 	params := map[string]interface{}{
@@ -0,0 +1,104 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GenerateExampleQuery generates a sample query for the given Object or
+// Interface type, selecting its leaf fields and those of the types it
+// references, up to depth levels deep. Fields with required arguments are
+// left out, and cycles between types are cut off by the depth limit.
+//
+// For a root operation type, the query is an operation of that type. For
+// other types, as they cannot be queried on their own, it is a fragment named
+// Example on the type, to be spread where the type is selected.
+//
+// Example:
+//
+//	query, err := GenerateExampleQuery(schema, "Query", 2)
+func GenerateExampleQuery(schema Schema, typeName string, depth int) (string, error) {
+	if err := invariantf(depth > 0, `Depth must be positive, got %v.`, depth); err != nil {
+		return "", err
+	}
+	ttype := schema.Type(typeName)
+	if err := invariantf(ttype != nil, `Type "%v" not found in schema.`, typeName); err != nil {
+		return "", err
+	}
+	fields, err := exampleFields(ttype)
+	if err != nil {
+		return "", err
+	}
+	lines := exampleSelections(fields, depth, "  ")
+	if err := invariantf(len(lines) > 0, `Type "%v" has no fields that can be selected.`, typeName); err != nil {
+		return "", err
+	}
+	selectionSet := fmt.Sprintf("{\n%v\n}", strings.Join(lines, "\n"))
+	switch ttype {
+	case schema.QueryType():
+		return selectionSet, nil
+	case schema.MutationType():
+		return "mutation " + selectionSet, nil
+	case schema.SubscriptionType():
+		return "subscription " + selectionSet, nil
+	}
+	return fmt.Sprintf("fragment Example on %v %v", typeName, selectionSet), nil
+}
+
+func exampleFields(ttype Type) (FieldDefinitionMap, error) {
+	switch ttype := ttype.(type) {
+	case *Object:
+		return ttype.Fields(), nil
+	case *Interface:
+		return ttype.Fields(), nil
+	}
+	return nil, invariantf(false, `Type "%v" must be an Object or Interface type.`, ttype)
+}
+
+// exampleSelections returns the lines selecting the given fields, each
+// prefixed with the given indentation.
+func exampleSelections(fields FieldDefinitionMap, depth int, indentation string) []string {
+	names := []string{}
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := []string{}
+	for _, name := range names {
+		field := fields[name]
+		if hasRequiredArgs(field) {
+			continue
+		}
+		switch ttype := GetNamed(field.Type).(type) {
+		case *Scalar, *Enum:
+			lines = append(lines, indentation+name)
+		case *Union:
+			if depth > 1 {
+				lines = append(lines, indentation+name+" {", indentation+"  __typename", indentation+"}")
+			}
+		case Type:
+			if depth <= 1 {
+				continue
+			}
+			subFields, _ := exampleFields(ttype)
+			subLines := exampleSelections(subFields, depth-1, indentation+"  ")
+			if len(subLines) == 0 {
+				continue
+			}
+			lines = append(lines, indentation+name+" {")
+			lines = append(lines, subLines...)
+			lines = append(lines, indentation+"}")
+		}
+	}
+	return lines
+}
+
+func hasRequiredArgs(field *FieldDefinition) bool {
+	for _, arg := range field.Args {
+		if _, ok := arg.Type.(*NonNull); ok && arg.DefaultValue == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestGenerateExampleQuery_SelectsFieldsUpToDepth(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		enum Episode { NEWHOPE EMPIRE JEDI }
+
+		type Character {
+			name: String!
+			appearsIn: [Episode]
+			friends(first: Int = 10): [Character]
+			friendsConnection(first: Int!): [Character]
+		}
+
+		type Query {
+			hero: Character
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := graphql.GenerateExampleQuery(schema, "Character", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `fragment Example on Character {
+  appearsIn
+  friends {
+    appearsIn
+    name
+  }
+  name
+}`
+	if query != expected {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, query))
+	}
+	if _, err := parser.Parse(parser.ParseParams{Source: query}); err != nil {
+		t.Fatalf("expected the example query to parse, got: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: "{ hero { ...Example } }\n" + query,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("expected the example fragment to be valid, got: %v", result.Errors)
+	}
+
+	query, err = graphql.GenerateExampleQuery(schema, "Query", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(query, "{\n") {
+		t.Fatalf("expected a query operation, got %v", query)
+	}
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("expected the example query to be valid, got: %v", result.Errors)
+	}
+}
+
+func TestGenerateExampleQuery_RejectsInvalidTypes(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		type Query {
+			search(text: String!): [String]
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		typeName string
+		depth    int
+		expected string
+	}{
+		{"Unknown", 1, `Type "Unknown" not found in schema.`},
+		{"String", 1, `Type "String" must be an Object or Interface type.`},
+		{"Query", 1, `Type "Query" has no fields that can be selected.`},
+		{"Query", 0, `Depth must be positive, got 0.`},
+	}
+	for _, test := range tests {
+		_, err := graphql.GenerateExampleQuery(schema, test.typeName, test.depth)
+		if err == nil || err.Error() != test.expected {
+			t.Fatalf("expected error %q, got %v", test.expected, err)
+		}
+	}
+}
@@ -5,8 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"sort"
+	"runtime/debug"
 	"strings"
+	"sync"
 
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
@@ -22,6 +23,42 @@ type ExecuteParams struct {
 	// Context may be provided to pass application-specific per-request
 	// information to resolve functions.
 	Context context.Context
+
+	// MaxRootFields limits how many top-level fields the operation may
+	// select. Zero means no limit.
+	MaxRootFields int
+
+	// MaxFragmentDepth limits how deeply fragments may be nested within a
+	// selection set. Zero means no limit.
+	MaxFragmentDepth int
+
+	// MaxNodes limits how many objects the operation may resolve, guarding
+	// against lists amplifying the work of a small query. Execution is
+	// aborted once the limit is exceeded. Zero means no limit.
+	MaxNodes int
+
+	// BatchThunks resolves the thunks returned by resolvers in waves across
+	// the whole result, rather than level by level: no thunk is called until
+	// every resolver that can run without waiting on one has run, so loads
+	// made at different depths of the query are batched together.
+	BatchThunks bool
+
+	// MaxConcurrency resolves the fields of a selection set concurrently, with
+	// at most MaxConcurrency resolvers running at once. The root fields of
+	// mutations are still resolved one after the other. Zero or one resolves
+	// fields sequentially.
+	MaxConcurrency int
+
+	// DisableRecover lets panics raised by resolvers escape Execute, rather
+	// than reporting them as field errors, which can help debugging them.
+	// The panic is raised again in the goroutine calling Execute, as a
+	// ResolverPanic. Subscriptions ignore it, as their results are sent from
+	// a goroutine of their own.
+	DisableRecover bool
+
+	// NonFiniteFloats decides how NaN and infinite float values of leaf
+	// fields are serialized. They are serialized as null by default.
+	NonFiniteFloats NonFiniteFloatPolicy
 }
 
 func Execute(p ExecuteParams) (result *Result) {
@@ -30,6 +67,14 @@ func Execute(p ExecuteParams) (result *Result) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	// the operation is resolved once for the extensions, before any resolver
+	// runs, as none may run at all
+	if p.AST != nil {
+		if operation, err := getOperation(p.AST, p.OperationName); err == nil && operation.Name != nil {
+			ctx = context.WithValue(ctx, operationNameContextKey{}, operation.Name.Value)
+			p.Context = ctx
+		}
+	}
 	// run executionDidStart functions from extensions
 	extErrs, executionFinishFn := handleExtensionsExecutionDidStart(&p)
 	if len(extErrs) != 0 {
@@ -39,6 +84,10 @@ func Execute(p ExecuteParams) (result *Result) {
 	}
 
 	defer func() {
+		// there is no result when a resolver panic is passed on to the caller
+		if result == nil {
+			return
+		}
 		extErrs = executionFinishFn(result)
 		if len(extErrs) != 0 {
 			result.Errors = append(result.Errors, extErrs...)
@@ -48,25 +97,40 @@ func Execute(p ExecuteParams) (result *Result) {
 	}()
 
 	resultChannel := make(chan *Result, 2)
+	started := make(chan *executionContext, 1)
+	var panicked *ResolverPanic
 
 	go func() {
 		result := &Result{}
 
 		defer func() {
 			if err := recover(); err != nil {
-				result.Errors = append(result.Errors, gqlerrors.FormatError(err.(error)))
+				if resolverErr, ok := err.(ResolverPanic); ok {
+					panicked = &resolverErr
+				} else if skipped, ok := err.(skippedFieldError); ok {
+					result.Errors = append(result.Errors, gqlerrors.FormatError(skipped.err))
+				} else {
+					result.Errors = append(result.Errors, gqlerrors.FormatError(err.(error)))
+				}
 			}
 			resultChannel <- result
 		}()
 
 		exeContext, err := buildExecutionContext(buildExecutionCtxParams{
-			Schema:        p.Schema,
-			Root:          p.Root,
-			AST:           p.AST,
-			OperationName: p.OperationName,
-			Args:          p.Args,
-			Result:        result,
-			Context:       p.Context,
+			Schema:           p.Schema,
+			Root:             p.Root,
+			AST:              p.AST,
+			OperationName:    p.OperationName,
+			Args:             p.Args,
+			Result:           result,
+			Context:          ctx,
+			MaxRootFields:    p.MaxRootFields,
+			MaxFragmentDepth: p.MaxFragmentDepth,
+			MaxNodes:         p.MaxNodes,
+			BatchThunks:      p.BatchThunks,
+			MaxConcurrency:   p.MaxConcurrency,
+			DisableRecover:   p.DisableRecover,
+			NonFiniteFloats:  p.NonFiniteFloats,
 		})
 
 		if err != nil {
@@ -74,58 +138,118 @@ func Execute(p ExecuteParams) (result *Result) {
 			resultChannel <- result
 			return
 		}
+		started <- exeContext
 
 		resultChannel <- executeOperation(executeOperationParams{
 			ExecutionContext: exeContext,
-			Root:             p.Root,
+			Root:             exeContext.Root,
 			Operation:        exeContext.Operation,
 		})
 	}()
 
 	select {
 	case <-ctx.Done():
+		// return the root fields completed so far, as the execution may be
+		// blocked in a resolver not returning when the context is done
+		select {
+		case exeContext := <-started:
+			return exeContext.partialResult(ctx.Err())
+		default:
+		}
 		result := &Result{}
 		result.Errors = append(result.Errors, gqlerrors.FormatError(ctx.Err()))
 		return result
 	case r := <-resultChannel:
+		if panicked != nil {
+			panic(*panicked)
+		}
 		return r
 	}
 }
 
+// ResolverPanic wraps a panic raised by a resolver when the execution does
+// not recover from them, so it is passed on rather than reported as an error.
+// It is raised again in the goroutine calling Execute, with the stack of the
+// resolver, which that goroutine does not have.
+type ResolverPanic struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (p ResolverPanic) String() string {
+	return fmt.Sprintf("%v\n\nresolver stack:\n%s", p.Value, p.Stack)
+}
+
 type buildExecutionCtxParams struct {
-	Schema        Schema
-	Root          interface{}
-	AST           *ast.Document
-	OperationName string
-	Args          map[string]interface{}
-	Result        *Result
-	Context       context.Context
+	Schema           Schema
+	Root             interface{}
+	AST              *ast.Document
+	OperationName    string
+	Args             map[string]interface{}
+	Result           *Result
+	Context          context.Context
+	MaxRootFields    int
+	MaxFragmentDepth int
+	MaxNodes         int
+	BatchThunks      bool
+	MaxConcurrency   int
+	DisableRecover   bool
+	NonFiniteFloats  NonFiniteFloatPolicy
 }
 
 type executionContext struct {
-	Schema         Schema
-	Fragments      map[string]ast.Definition
-	Root           interface{}
-	Operation      ast.Definition
-	VariableValues map[string]interface{}
-	Errors         []gqlerrors.FormattedError
-	Context        context.Context
+	Schema           Schema
+	Fragments        map[string]ast.Definition
+	Root             interface{}
+	Operation        ast.Definition
+	VariableValues   map[string]interface{}
+	Errors           []gqlerrors.FormattedError
+	Warnings         []gqlerrors.FormattedError
+	Context          context.Context
+	MaxRootFields    int
+	MaxFragmentDepth int
+	MaxNodes         int
+	BatchThunks      bool
+	MaxConcurrency   int
+	DisableRecover   bool
+	NonFiniteFloats  NonFiniteFloatPolicy
+
+	// workers holds a token for each goroutine resolving fields, to bound
+	// them by MaxConcurrency.
+	workers chan struct{}
+
+	// mu guards Errors, Warnings and the fields below, which fields resolved
+	// concurrently update.
+	mu sync.Mutex
+
+	// resolvedNodes counts the objects resolved so far, to enforce MaxNodes.
+	resolvedNodes int
+
+	// deprecatedFieldsSeen records the deprecated fields that were already
+	// warned about, so fields resolved within lists are only reported once.
+	deprecatedFieldsSeen map[*FieldDefinition]bool
+
+	// cancelled is set once a field is skipped as the context is done, and
+	// cancellationReported once that is reported, so it is only reported once.
+	cancelled            bool
+	cancellationReported bool
+
+	// rootFields are the root fields of the operation, and completedRootFields
+	// the ones completed so far, returned when the context is done before the
+	// execution is.
+	rootType            *Object
+	rootFields          map[string][]*ast.Field
+	rootOrder           []string
+	completedRootFields map[string]interface{}
 }
 
 func buildExecutionContext(p buildExecutionCtxParams) (*executionContext, error) {
 	eCtx := &executionContext{}
-	var operation *ast.OperationDefinition
 	fragments := map[string]ast.Definition{}
 
 	for _, definition := range p.AST.Definitions {
 		switch definition := definition.(type) {
 		case *ast.OperationDefinition:
-			if (p.OperationName == "") && operation != nil {
-				return nil, errors.New("Must provide operation name if query contains multiple operations.")
-			}
-			if p.OperationName == "" || definition.GetName() != nil && definition.GetName().Value == p.OperationName {
-				operation = definition
-			}
 		case *ast.FragmentDefinition:
 			key := ""
 			if definition.GetName() != nil && definition.GetName().Value != "" {
@@ -137,11 +261,9 @@ func buildExecutionContext(p buildExecutionCtxParams) (*executionContext, error)
 		}
 	}
 
-	if operation == nil {
-		if p.OperationName != "" {
-			return nil, fmt.Errorf(`Unknown operation named "%v".`, p.OperationName)
-		}
-		return nil, fmt.Errorf(`Must provide an operation.`)
+	operation, err := getOperation(p.AST, p.OperationName)
+	if err != nil {
+		return nil, err
 	}
 
 	variableValues, err := getVariableValues(p.Schema, operation.GetVariableDefinitions(), p.Args)
@@ -151,13 +273,81 @@ func buildExecutionContext(p buildExecutionCtxParams) (*executionContext, error)
 
 	eCtx.Schema = p.Schema
 	eCtx.Fragments = fragments
-	eCtx.Root = p.Root
+	eCtx.Root = operationRoot(p.Root, operation)
 	eCtx.Operation = operation
 	eCtx.VariableValues = variableValues
 	eCtx.Context = p.Context
+	eCtx.MaxRootFields = p.MaxRootFields
+	eCtx.MaxFragmentDepth = p.MaxFragmentDepth
+	eCtx.MaxNodes = p.MaxNodes
+	eCtx.BatchThunks = p.BatchThunks
+	eCtx.MaxConcurrency = p.MaxConcurrency
+	if p.MaxConcurrency > 1 {
+		// the goroutine executing the operation resolves fields too
+		eCtx.workers = make(chan struct{}, p.MaxConcurrency-1)
+	}
+	eCtx.DisableRecover = p.DisableRecover
+	eCtx.NonFiniteFloats = p.NonFiniteFloats
 	return eCtx, nil
 }
 
+// OperationRoots may be given as the root value to use a different root for
+// each type of operation, e.g. a transaction handle for mutations only.
+type OperationRoots struct {
+	Query        interface{}
+	Mutation     interface{}
+	Subscription interface{}
+}
+
+// operationRoot returns the root value of the operation, picking the one for
+// its type if the root value is an OperationRoots.
+func operationRoot(root interface{}, operation *ast.OperationDefinition) interface{} {
+	var roots OperationRoots
+	switch root := root.(type) {
+	case OperationRoots:
+		roots = root
+	case *OperationRoots:
+		if root == nil {
+			return nil
+		}
+		roots = *root
+	default:
+		return root
+	}
+	switch operation.GetOperation() {
+	case ast.OperationTypeMutation:
+		return roots.Mutation
+	case ast.OperationTypeSubscription:
+		return roots.Subscription
+	}
+	return roots.Query
+}
+
+// getOperation returns the operation of the document with the given name, or
+// its only operation if no name is given.
+func getOperation(doc *ast.Document, operationName string) (*ast.OperationDefinition, error) {
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		definition, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" && operation != nil {
+			return nil, errors.New("Must provide operation name if query contains multiple operations.")
+		}
+		if operationName == "" || definition.GetName() != nil && definition.GetName().Value == operationName {
+			operation = definition
+		}
+	}
+	if operation == nil {
+		if operationName != "" {
+			return nil, fmt.Errorf(`Unknown operation named "%v".`, operationName)
+		}
+		return nil, fmt.Errorf(`Must provide an operation.`)
+	}
+	return operation, nil
+}
+
 type executeOperationParams struct {
 	ExecutionContext *executionContext
 	Root             interface{}
@@ -170,24 +360,172 @@ func executeOperation(p executeOperationParams) *Result {
 		return &Result{Errors: gqlerrors.FormatErrors(err)}
 	}
 
+	order := []string{}
 	fields := collectFields(collectFieldsParams{
 		ExeContext:   p.ExecutionContext,
 		RuntimeType:  operationType,
 		SelectionSet: p.Operation.GetSelectionSet(),
+		Order:        &order,
 	})
 
+	if err := checkMaxRootFields(p.ExecutionContext, fields); err != nil {
+		return &Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	p.ExecutionContext.setRootFields(operationType, fields, order)
+
 	executeFieldsParams := executeFieldsParams{
 		ExecutionContext: p.ExecutionContext,
 		ParentType:       operationType,
 		Source:           p.Root,
 		Fields:           fields,
+		Order:            order,
 	}
 
+	var result *Result
 	if p.Operation.GetOperation() == ast.OperationTypeMutation {
-		return executeFieldsSerially(executeFieldsParams)
+		result = executeFieldsSerially(executeFieldsParams)
+	} else {
+		result = executeFields(executeFieldsParams)
 	}
-	return executeFields(executeFieldsParams)
 
+	if exceedsMaxNodes(p.ExecutionContext) {
+		return &Result{Errors: gqlerrors.FormatErrors(gqlerrors.NewError(
+			fmt.Sprintf("Operation resolves more than the maximum of %v nodes.", p.ExecutionContext.MaxNodes),
+			[]ast.Node{p.Operation},
+			"",
+			nil,
+			[]int{},
+			nil,
+		))}
+	}
+	return result
+}
+
+// checkMaxRootFields returns an error when the operation selects more root
+// fields than the execution allows.
+func checkMaxRootFields(eCtx *executionContext, fields map[string][]*ast.Field) error {
+	if eCtx.MaxRootFields <= 0 || len(fields) <= eCtx.MaxRootFields {
+		return nil
+	}
+	return gqlerrors.NewError(
+		fmt.Sprintf("Operation selects %v root fields, which exceeds the maximum of %v.", len(fields), eCtx.MaxRootFields),
+		[]ast.Node{eCtx.Operation},
+		"",
+		nil,
+		[]int{},
+		nil,
+	)
+}
+
+// exceedsMaxNodes returns true once the execution resolved more objects than
+// it allows.
+func exceedsMaxNodes(eCtx *executionContext) bool {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	return eCtx.MaxNodes > 0 && eCtx.resolvedNodes > eCtx.MaxNodes
+}
+
+// addErrors records errors of the execution.
+func (eCtx *executionContext) addErrors(errs ...gqlerrors.FormattedError) {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	eCtx.Errors = append(eCtx.Errors, errs...)
+}
+
+// markCancelled records that a field was skipped as the context is done.
+func (eCtx *executionContext) markCancelled() {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	eCtx.cancelled = true
+}
+
+// reportCancellation returns true the first time it is called, for the
+// cancellation to be reported once.
+func (eCtx *executionContext) reportCancellation() bool {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	first := !eCtx.cancellationReported
+	eCtx.cancellationReported = true
+	return first
+}
+
+// skippedFieldError is raised by fields skipped as the context is done. It
+// nulls fields as any field error, but is only reported once.
+type skippedFieldError struct {
+	err error
+}
+
+func (e skippedFieldError) Error() string {
+	return e.err.Error()
+}
+
+// setRootFields records the root fields of the operation, to return the ones
+// completed when the context is done before the execution is.
+func (eCtx *executionContext) setRootFields(rootType *Object, fields map[string][]*ast.Field, order []string) {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	eCtx.rootType = rootType
+	eCtx.rootFields = fields
+	eCtx.rootOrder = order
+	eCtx.completedRootFields = map[string]interface{}{}
+}
+
+// completeRootField records the value of a root field once it is completed.
+// Values holding thunks are completed later on, so they are left out, as are
+// values completed once fields are skipped, as they may hold skipped fields.
+func (eCtx *executionContext) completeRootField(responseName string, value interface{}) {
+	if len(collectMapThunks(map[string]interface{}{responseName: value}, nil)) != 0 {
+		return
+	}
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	if eCtx.completedRootFields != nil && !eCtx.cancelled {
+		eCtx.completedRootFields[responseName] = value
+	}
+}
+
+// partialResult is the result of the root fields completed so far, with the
+// given error. The other root fields are null, and so is the data once one of
+// them is non-null.
+func (eCtx *executionContext) partialResult(err error) *Result {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	result := &Result{}
+	if eCtx.rootFields != nil {
+		data := make(map[string]interface{}, len(eCtx.rootOrder))
+		for _, responseName := range eCtx.rootOrder {
+			if value, ok := eCtx.completedRootFields[responseName]; ok {
+				data[responseName] = value
+				continue
+			}
+			fieldAST := eCtx.rootFields[responseName][0]
+			fieldDef := getFieldDef(eCtx.Schema, eCtx.rootType, fieldAST.Name.Value)
+			if fieldDef == nil {
+				continue
+			}
+			if _, ok := fieldDef.Type.(*NonNull); ok {
+				data = nil
+				break
+			}
+			data[responseName] = nil
+		}
+		if data != nil {
+			result.Data = data
+		}
+		for _, fieldErr := range eCtx.Errors {
+			if len(fieldErr.Path) == 0 {
+				continue
+			}
+			if responseName, ok := fieldErr.Path[0].(string); ok {
+				if _, ok := eCtx.completedRootFields[responseName]; ok {
+					result.Errors = append(result.Errors, fieldErr)
+				}
+			}
+		}
+	}
+	result.Errors = append(result.Errors, gqlerrors.FormatError(err))
+	return result
 }
 
 // Extracts the root type of the operation from the schema.
@@ -243,6 +581,8 @@ type executeFieldsParams struct {
 	Source           interface{}
 	Fields           map[string][]*ast.Field
 	Path             *ResponsePath
+	// Order is the order the fields were collected in, see collectFieldsParams.
+	Order []string
 }
 
 // Implements the "Evaluating selection sets" section of the spec for "write" mode.
@@ -255,21 +595,27 @@ func executeFieldsSerially(p executeFieldsParams) *Result {
 	}
 
 	finalResults := make(map[string]interface{}, len(p.Fields))
-	for _, orderedField := range orderedFields(p.Fields) {
-		responseName := orderedField.responseName
-		fieldASTs := orderedField.fieldASTs
+	for _, responseName := range p.Order {
+		fieldASTs := p.Fields[responseName]
 		fieldPath := p.Path.WithKey(responseName)
 		resolved, state := resolveField(p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
 		if state.hasNoFieldDefs {
 			continue
 		}
-		finalResults[responseName] = resolved
+		// a field is completed, calling any thunks it returned, before the
+		// next field is resolved.
+		fieldResult := map[string]interface{}{responseName: resolved}
+		dethunkMapDepthFirst(fieldResult)
+		finalResults[responseName] = fieldResult[responseName]
+		if p.Path == nil {
+			p.ExecutionContext.completeRootField(responseName, finalResults[responseName])
+		}
 	}
-	dethunkMapDepthFirst(finalResults)
 
 	return &Result{
-		Data:   finalResults,
-		Errors: p.ExecutionContext.Errors,
+		Data:     finalResults,
+		Errors:   p.ExecutionContext.Errors,
+		Warnings: p.ExecutionContext.Warnings,
 	}
 }
 
@@ -277,11 +623,16 @@ func executeFieldsSerially(p executeFieldsParams) *Result {
 func executeFields(p executeFieldsParams) *Result {
 	finalResults := executeSubFields(p)
 
-	dethunkMapWithBreadthFirstTraversal(finalResults)
+	if p.ExecutionContext.BatchThunks {
+		dethunkMapInWaves(finalResults)
+	} else {
+		dethunkMapWithBreadthFirstTraversal(finalResults)
+	}
 
 	return &Result{
-		Data:   finalResults,
-		Errors: p.ExecutionContext.Errors,
+		Data:     finalResults,
+		Errors:   p.ExecutionContext.Errors,
+		Warnings: p.ExecutionContext.Warnings,
 	}
 }
 
@@ -295,6 +646,10 @@ func executeSubFields(p executeFieldsParams) map[string]interface{} {
 	}
 
 	finalResults := make(map[string]interface{}, len(p.Fields))
+	if p.ExecutionContext.workers != nil && len(p.Fields) > 1 {
+		executeSubFieldsConcurrently(p, finalResults)
+		return finalResults
+	}
 	for responseName, fieldASTs := range p.Fields {
 		fieldPath := p.Path.WithKey(responseName)
 		resolved, state := resolveField(p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
@@ -302,11 +657,68 @@ func executeSubFields(p executeFieldsParams) map[string]interface{} {
 			continue
 		}
 		finalResults[responseName] = resolved
+		if p.Path == nil {
+			p.ExecutionContext.completeRootField(responseName, resolved)
+		}
 	}
 
 	return finalResults
 }
 
+// executeSubFieldsConcurrently resolves each field in a new goroutine while
+// the execution has a worker to spare, and in the calling goroutine otherwise,
+// so selection sets nested in the fields can never wait on a worker. Panics,
+// such as a null propagating from a non-null field, are raised again once
+// every field is resolved.
+func executeSubFieldsConcurrently(p executeFieldsParams, finalResults map[string]interface{}) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		panicked interface{}
+	)
+	resolve := func(responseName string, fieldASTs []*ast.Field) {
+		defer func() {
+			if r := recover(); r != nil {
+				mu.Lock()
+				if panicked == nil {
+					panicked = r
+				}
+				mu.Unlock()
+			}
+		}()
+		fieldPath := p.Path.WithKey(responseName)
+		resolved, state := resolveField(p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
+		if state.hasNoFieldDefs {
+			return
+		}
+		mu.Lock()
+		finalResults[responseName] = resolved
+		mu.Unlock()
+		if p.Path == nil {
+			p.ExecutionContext.completeRootField(responseName, resolved)
+		}
+	}
+	for responseName, fieldASTs := range p.Fields {
+		select {
+		case p.ExecutionContext.workers <- struct{}{}:
+			wg.Add(1)
+			go func(responseName string, fieldASTs []*ast.Field) {
+				defer func() {
+					<-p.ExecutionContext.workers
+					wg.Done()
+				}()
+				resolve(responseName, fieldASTs)
+			}(responseName, fieldASTs)
+		default:
+			resolve(responseName, fieldASTs)
+		}
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
 // dethunkQueue is a structure that allows us to execute a classic breadth-first traversal.
 type dethunkQueue struct {
 	DethunkFuncs []func()
@@ -363,6 +775,53 @@ func dethunkListBreadthFirst(list []interface{}, dethunkQueue *dethunkQueue) {
 	}
 }
 
+// dethunkMapInWaves calls the thunks in the map in waves, replacing each thunk
+// with its return value. Each wave collects the thunks pending anywhere in the
+// map before calling any of them, so the first thunk called sees the loads of
+// every resolver that ran before it. Thunks returned while completing a wave
+// are called in the next one.
+func dethunkMapInWaves(finalResults map[string]interface{}) {
+	for {
+		thunks := collectMapThunks(finalResults, nil)
+		if len(thunks) == 0 {
+			return
+		}
+		for _, dethunk := range thunks {
+			dethunk()
+		}
+	}
+}
+
+func collectMapThunks(m map[string]interface{}, thunks []func()) []func() {
+	for k, v := range m {
+		switch val := v.(type) {
+		case func() interface{}:
+			k := k
+			thunks = append(thunks, func() { m[k] = val() })
+		case map[string]interface{}:
+			thunks = collectMapThunks(val, thunks)
+		case []interface{}:
+			thunks = collectListThunks(val, thunks)
+		}
+	}
+	return thunks
+}
+
+func collectListThunks(list []interface{}, thunks []func()) []func() {
+	for i, v := range list {
+		switch val := v.(type) {
+		case func() interface{}:
+			i := i
+			thunks = append(thunks, func() { list[i] = val() })
+		case map[string]interface{}:
+			thunks = collectMapThunks(val, thunks)
+		case []interface{}:
+			thunks = collectListThunks(val, thunks)
+		}
+	}
+	return thunks
+}
+
 // dethunkMapDepthFirst performs a serial descent of the map, calling any thunks
 // in the map values and replacing each thunk with that thunk's return value. This is needed
 // to conform to the graphql-js reference implementation, which requires serial (depth-first)
@@ -401,6 +860,11 @@ type collectFieldsParams struct {
 	SelectionSet         *ast.SelectionSet
 	Fields               map[string][]*ast.Field
 	VisitedFragmentNames map[string]bool
+	// Depth is how many fragments the selection set is nested in.
+	Depth int
+	// Order, if set, records the response names in the order they are first
+	// collected, which is their order in the document with fragments expanded.
+	Order *[]string
 }
 
 // Given a selectionSet, adds all of the fields in that selection to
@@ -429,6 +893,9 @@ func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
 			name := getFieldEntryKey(selection)
 			if _, ok := fields[name]; !ok {
 				fields[name] = []*ast.Field{}
+				if p.Order != nil {
+					*p.Order = append(*p.Order, name)
+				}
 			}
 			fields[name] = append(fields[name], selection)
 		case *ast.InlineFragment:
@@ -437,12 +904,15 @@ func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
 				!doesFragmentConditionMatch(p.ExeContext, selection, p.RuntimeType) {
 				continue
 			}
+			checkFragmentDepth(p)
 			innerParams := collectFieldsParams{
 				ExeContext:           p.ExeContext,
 				RuntimeType:          p.RuntimeType,
 				SelectionSet:         selection.SelectionSet,
 				Fields:               fields,
 				VisitedFragmentNames: p.VisitedFragmentNames,
+				Depth:                p.Depth + 1,
+				Order:                p.Order,
 			}
 			collectFields(innerParams)
 		case *ast.FragmentSpread:
@@ -464,12 +934,15 @@ func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
 				if !doesFragmentConditionMatch(p.ExeContext, fragment, p.RuntimeType) {
 					continue
 				}
+				checkFragmentDepth(p)
 				innerParams := collectFieldsParams{
 					ExeContext:           p.ExeContext,
 					RuntimeType:          p.RuntimeType,
 					SelectionSet:         fragment.GetSelectionSet(),
 					Fields:               fields,
 					VisitedFragmentNames: p.VisitedFragmentNames,
+					Depth:                p.Depth + 1,
+					Order:                p.Order,
 				}
 				collectFields(innerParams)
 			}
@@ -478,6 +951,14 @@ func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
 	return fields
 }
 
+// checkFragmentDepth panics if expanding another fragment within the
+// selection set would nest fragments deeper than the execution allows.
+func checkFragmentDepth(p collectFieldsParams) {
+	if maxDepth := p.ExeContext.MaxFragmentDepth; maxDepth > 0 && p.Depth >= maxDepth {
+		panic(fmt.Errorf("Fragments are nested deeper than the maximum depth of %v.", maxDepth))
+	}
+}
+
 // Determines if a field should be included based on the @include and @skip
 // directives, where @skip has higher precedence than @include.
 func shouldIncludeNode(eCtx *executionContext, directives []*ast.Directive) bool {
@@ -581,12 +1062,24 @@ type resolveFieldResultState struct {
 }
 
 func handleFieldError(r interface{}, fieldNodes []ast.Node, path *ResponsePath, returnType Output, eCtx *executionContext) {
+	if r, ok := r.(ResolverPanic); ok {
+		panic(r)
+	}
+	if skipped, ok := r.(skippedFieldError); ok {
+		if _, ok := returnType.(*NonNull); ok {
+			panic(skipped)
+		}
+		if eCtx.reportCancellation() {
+			eCtx.addErrors(gqlerrors.FormatError(NewLocatedErrorWithPath(skipped.err, fieldNodes, path.AsArray())))
+		}
+		return
+	}
 	err := NewLocatedErrorWithPath(r, fieldNodes, path.AsArray())
 	// send panic upstream
 	if _, ok := returnType.(*NonNull); ok {
 		panic(err)
 	}
-	eCtx.Errors = append(eCtx.Errors, gqlerrors.FormatError(err))
+	eCtx.addErrors(gqlerrors.FormatError(err))
 }
 
 // Resolves the field on the given source object. In particular, this
@@ -598,6 +1091,8 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 	var returnType Output
 	defer func() (interface{}, resolveFieldResultState) {
 		if r := recover(); r != nil {
+			// the field is null once it errors, whatever its resolver returned
+			result = nil
 			handleFieldError(r, FieldASTsToNodeASTs(fieldASTs), path, returnType, eCtx)
 			return result, resultState
 		}
@@ -616,6 +1111,24 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 		return nil, resultState
 	}
 	returnType = fieldDef.Type
+
+	// Stop resolving fields once the request is cancelled or past its deadline.
+	if eCtx.Context != nil {
+		if err := eCtx.Context.Err(); err != nil {
+			eCtx.markCancelled()
+			panic(skippedFieldError{err: err})
+		}
+	}
+
+	// Stop resolving fields once the execution is aborted for resolving too
+	// many objects.
+	if exceedsMaxNodes(eCtx) {
+		panic(errors.New("Maximum number of nodes exceeded."))
+	}
+
+	if fieldDef.DeprecationReason != "" {
+		warnDeprecatedField(eCtx, parentType, fieldDef, fieldASTs, path)
+	}
 	resolveFn := fieldDef.Resolve
 	if resolveFn == nil {
 		resolveFn = DefaultResolveFn
@@ -625,6 +1138,12 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 	// variables scope to fulfill any variable references.
 	// TODO: find a way to memoize, in case this field is within a List type.
 	args := getArgumentValues(fieldDef.Args, fieldAST.Arguments, eCtx.VariableValues)
+	if fieldDef.CoerceArgs != nil {
+		var err error
+		if args, err = fieldDef.CoerceArgs(args); err != nil {
+			panic(err)
+		}
+	}
 
 	info := ResolveInfo{
 		FieldName:      fieldName,
@@ -643,10 +1162,10 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 
 	extErrs, resolveFieldFinishFn := handleExtensionsResolveFieldDidStart(eCtx.Schema.extensions, eCtx, &info)
 	if len(extErrs) != 0 {
-		eCtx.Errors = append(eCtx.Errors, extErrs...)
+		eCtx.addErrors(extErrs...)
 	}
 
-	result, resolveFnError = resolveFn(ResolveParams{
+	result, resolveFnError = callResolveFn(eCtx, resolveFn, ResolveParams{
 		Source:  source,
 		Args:    args,
 		Info:    info,
@@ -655,9 +1174,14 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 
 	extErrs = resolveFieldFinishFn(result, resolveFnError)
 	if len(extErrs) != 0 {
-		eCtx.Errors = append(eCtx.Errors, extErrs...)
+		eCtx.addErrors(extErrs...)
+	}
+
+	if resolveFnError != nil {
+		panic(resolveFnError)
 	}
 
+	result, resolveFnError = resolveFieldDirectives(eCtx, fieldDirectives(eCtx, fieldASTs), result, info)
 	if resolveFnError != nil {
 		panic(resolveFnError)
 	}
@@ -666,6 +1190,95 @@ func resolveField(eCtx *executionContext, parentType *Object, source interface{}
 	return completed, resultState
 }
 
+// callResolveFn calls the resolver of a field. Its panics are recovered from
+// by resolveField, unless the execution disables recovering from them.
+func callResolveFn(eCtx *executionContext, resolveFn FieldResolveFn, p ResolveParams) (interface{}, error) {
+	if eCtx.DisableRecover {
+		defer func() {
+			if r := recover(); r != nil {
+				panic(ResolverPanic{Value: r, Stack: debug.Stack()})
+			}
+		}()
+	}
+	return resolveFn(p)
+}
+
+// fieldDirectives returns the directives with a Resolve hook applied to the
+// merged selections of a field, in the order they appear. A directive applied
+// by several of the selections is only returned once.
+func fieldDirectives(eCtx *executionContext, fieldASTs []*ast.Field) []*ast.Directive {
+	var directives []*ast.Directive
+	seen := map[string]bool{}
+	for _, fieldAST := range fieldASTs {
+		for _, directiveAST := range fieldAST.Directives {
+			if directiveAST.Name == nil || seen[directiveAST.Name.Value] {
+				continue
+			}
+			directive := eCtx.Schema.Directive(directiveAST.Name.Value)
+			if directive == nil || directive.Resolve == nil {
+				continue
+			}
+			seen[directiveAST.Name.Value] = true
+			directives = append(directives, directiveAST)
+		}
+	}
+	return directives
+}
+
+// resolveFieldDirectives passes the resolved value of a field through the
+// Resolve hook of each of the directives, in order. When the value is a thunk,
+// they are passed the value it returns once it is called.
+func resolveFieldDirectives(eCtx *executionContext, directives []*ast.Directive, value interface{}, info ResolveInfo) (interface{}, error) {
+	if len(directives) == 0 {
+		return value, nil
+	}
+	if isThunk(value) {
+		thunk := value
+		return func(ctx context.Context) (interface{}, error) {
+			value, err := callThunk(ctx, thunk)
+			if err != nil {
+				return nil, err
+			}
+			return resolveFieldDirectives(eCtx, directives, value, info)
+		}, nil
+	}
+	for _, directiveAST := range directives {
+		directive := eCtx.Schema.Directive(directiveAST.Name.Value)
+		var err error
+		value, err = directive.Resolve(DirectiveResolveParams{
+			Value:   value,
+			Args:    getArgumentValues(directive.Args, directiveAST.Arguments, eCtx.VariableValues),
+			Info:    info,
+			Context: eCtx.Context,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// warnDeprecatedField records a warning about the use of a deprecated field,
+// with its deprecation reason and the path at which it was first resolved. A
+// field is reported once per field definition.
+func warnDeprecatedField(eCtx *executionContext, parentType *Object, fieldDef *FieldDefinition, fieldASTs []*ast.Field, path *ResponsePath) {
+	eCtx.mu.Lock()
+	defer eCtx.mu.Unlock()
+	if eCtx.deprecatedFieldsSeen[fieldDef] {
+		return
+	}
+	if eCtx.deprecatedFieldsSeen == nil {
+		eCtx.deprecatedFieldsSeen = map[*FieldDefinition]bool{}
+	}
+	eCtx.deprecatedFieldsSeen[fieldDef] = true
+	err := NewLocatedErrorWithPath(
+		fmt.Sprintf(`The field "%v.%v" is deprecated. %v`, parentType.Name(), fieldDef.Name, fieldDef.DeprecationReason),
+		FieldASTsToNodeASTs(fieldASTs),
+		path.AsArray(),
+	)
+	eCtx.Warnings = append(eCtx.Warnings, gqlerrors.FormatError(err))
+}
+
 func completeValueCatchingError(eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result interface{}) (completed interface{}) {
 	// catch panic
 	defer func() interface{} {
@@ -686,8 +1299,7 @@ func completeValueCatchingError(eCtx *executionContext, returnType Type, fieldAS
 
 func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result interface{}) interface{} {
 
-	resultVal := reflect.ValueOf(result)
-	if resultVal.IsValid() && resultVal.Kind() == reflect.Func {
+	if isThunk(result) {
 		return func() interface{} {
 			return completeThunkValueCatchingError(eCtx, returnType, fieldASTs, info, path, result)
 		}
@@ -708,8 +1320,9 @@ func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Fie
 		return completed
 	}
 
-	// If result value is null-ish (null, undefined, or NaN) then return null.
-	if isNullish(result) {
+	// If result value is null-ish (null, undefined, or NaN) then return null,
+	// unless NaN is to be reported as an error when serialized.
+	if isNullish(result) && !(eCtx.NonFiniteFloats == NonFiniteFloatAsError && isNonFiniteFloat(result)) {
 		return nil
 	}
 
@@ -721,10 +1334,10 @@ func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Fie
 	// If field type is a leaf type, Scalar or Enum, serialize to a valid value,
 	// returning null if serialization is not possible.
 	if returnType, ok := returnType.(*Scalar); ok {
-		return completeLeafValue(returnType, result)
+		return completeLeafValue(eCtx, returnType, result)
 	}
 	if returnType, ok := returnType.(*Enum); ok {
-		return completeLeafValue(returnType, result)
+		return completeLeafValue(eCtx, returnType, result)
 	}
 
 	// If field type is an abstract type, Interface or Union, determine the
@@ -760,12 +1373,7 @@ func completeThunkValueCatchingError(eCtx *executionContext, returnType Type, fi
 		}
 	}()
 
-	propertyFn, ok := result.(func() (interface{}, error))
-	if !ok {
-		err := gqlerrors.NewFormattedError("Error resolving func. Expected `func() (interface{}, error)` signature")
-		panic(gqlerrors.FormatError(err))
-	}
-	fnResult, err := propertyFn()
+	fnResult, err := callThunk(eCtx.Context, result)
 	if err != nil {
 		panic(gqlerrors.FormatError(err))
 	}
@@ -781,6 +1389,28 @@ func completeThunkValueCatchingError(eCtx *executionContext, returnType Type, fi
 	return completed
 }
 
+// isThunk returns true when a resolved value is a thunk, a function called to
+// get the value of the field once the other fields are resolved.
+func isThunk(result interface{}) bool {
+	resultVal := reflect.ValueOf(result)
+	return resultVal.IsValid() && resultVal.Kind() == reflect.Func && !isListIterator(result)
+}
+
+// callThunk calls a thunk and returns its value.
+func callThunk(ctx context.Context, thunk interface{}) (interface{}, error) {
+	switch thunk := thunk.(type) {
+	case func() (interface{}, error):
+		return thunk()
+	case func(context.Context) (interface{}, error):
+		// the thunk is given the context of the request, as it may run after
+		// the resolver returned
+		return thunk(ctx)
+	default:
+		return nil, gqlerrors.NewFormattedError("Error resolving func. Expected `func() (interface{}, error)` " +
+			"or `func(context.Context) (interface{}, error)` signature")
+	}
+}
+
 // completeAbstractValue completes value of an Abstract type (Union / Interface) by determining the runtime type
 // of that value, then completing based on that type.
 func completeAbstractValue(eCtx *executionContext, returnType Abstract, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result interface{}) interface{} {
@@ -792,16 +1422,20 @@ func completeAbstractValue(eCtx *executionContext, returnType Abstract, fieldAST
 		Info:    info,
 		Context: eCtx.Context,
 	}
-	if unionReturnType, ok := returnType.(*Union); ok && unionReturnType.ResolveType != nil {
-		runtimeType = unionReturnType.ResolveType(resolveTypeParams)
-	} else if interfaceReturnType, ok := returnType.(*Interface); ok && interfaceReturnType.ResolveType != nil {
-		runtimeType = interfaceReturnType.ResolveType(resolveTypeParams)
-	} else {
-		runtimeType = defaultResolveTypeFn(resolveTypeParams, returnType)
+	if resolveType := eCtx.Schema.resolveTypeFn(returnType); resolveType != nil {
+		runtimeType = resolveType(resolveTypeParams)
+	}
+	// A ResolveType function returning nil defers to the IsTypeOf functions
+	// of the possible types.
+	if runtimeType == nil {
+		var err error
+		if runtimeType, err = defaultResolveTypeFn(resolveTypeParams, returnType); err != nil {
+			panic(err)
+		}
 	}
 
-	err := invariantf(runtimeType != nil, `Abstract type %v must resolve to an Object type at runtime `+
-		`for field %v.%v with value "%v", received "%v".`, returnType, info.ParentType, info.FieldName, result, runtimeType,
+	err := invariantf(runtimeType != nil, `Abstract type "%v" must resolve to an Object type at runtime `+
+		`for field "%v.%v" with value "%v", received "%v".`, returnType, info.ParentType, info.FieldName, result, runtimeType,
 	)
 	if err != nil {
 		panic(err)
@@ -836,6 +1470,13 @@ func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs [
 		}
 	}
 
+	eCtx.mu.Lock()
+	eCtx.resolvedNodes++
+	eCtx.mu.Unlock()
+	if exceedsMaxNodes(eCtx) {
+		panic(errors.New("Maximum number of nodes exceeded."))
+	}
+
 	// Collect sub-fields to execute to complete this value.
 	subFieldASTs := map[string][]*ast.Field{}
 	visitedFragmentNames := map[string]bool{}
@@ -866,9 +1507,18 @@ func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs [
 }
 
 // completeLeafValue complete a leaf value (Scalar / Enum) by serializing to a valid value, returning nil if serialization is not possible.
-func completeLeafValue(returnType Leaf, result interface{}) interface{} {
-	serializedResult := returnType.Serialize(result)
-	if isNullish(serializedResult) {
+func completeLeafValue(eCtx *executionContext, returnType Leaf, result interface{}) interface{} {
+	serialize := returnType.Serialize
+	if returnType == ID && eCtx.Schema.serializeID != nil {
+		serialize = eCtx.Schema.serializeID
+	}
+	serializedResult := serialize(result)
+	if eCtx.NonFiniteFloats == NonFiniteFloatAsError && isNonFiniteFloat(serializedResult) {
+		panic(gqlerrors.NewFormattedError(
+			fmt.Sprintf(`%v cannot represent non-finite value: %v`, returnType.Name(), serializedResult),
+		))
+	}
+	if isNullish(serializedResult) || isNonFiniteFloat(serializedResult) {
 		return nil
 	}
 	return serializedResult
@@ -876,6 +1526,9 @@ func completeLeafValue(returnType Leaf, result interface{}) interface{} {
 
 // completeListValue complete a list value by completing each item in the list with the inner type
 func completeListValue(eCtx *executionContext, returnType *List, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result interface{}) interface{} {
+	if next, ok := result.(func() (interface{}, bool)); ok {
+		result = drainListIterator(next)
+	}
 	resultVal := reflect.ValueOf(result)
 	if resultVal.Kind() == reflect.Ptr {
 		resultVal = resultVal.Elem()
@@ -904,10 +1557,32 @@ func completeListValue(eCtx *executionContext, returnType *List, fieldASTs []*as
 	return completedResults
 }
 
+// isListIterator returns true if the resolved value is an iterator function,
+// which is drained into a list rather than being called as a thunk.
+func isListIterator(result interface{}) bool {
+	_, ok := result.(func() (interface{}, bool))
+	return ok
+}
+
+// drainListIterator calls next until it reports that there are no more values,
+// collecting the values returned so far.
+func drainListIterator(next func() (interface{}, bool)) []interface{} {
+	values := []interface{}{}
+	for {
+		value, ok := next()
+		if !ok {
+			return values
+		}
+		values = append(values, value)
+	}
+}
+
 // defaultResolveTypeFn If a resolveType function is not given, then a default resolve behavior is
 // used which tests each possible type for the abstract type by calling
-// isTypeOf for the object being coerced, returning the first type that matches.
-func defaultResolveTypeFn(p ResolveTypeParams, abstractType Abstract) *Object {
+// isTypeOf for the object being coerced, returning the type that matches.
+// More than one type matching is an error, as the value could be either.
+func defaultResolveTypeFn(p ResolveTypeParams, abstractType Abstract) (*Object, error) {
+	var runtimeType *Object
 	possibleTypes := p.Info.Schema.PossibleTypes(abstractType)
 	for _, possibleType := range possibleTypes {
 		if possibleType.IsTypeOf == nil {
@@ -918,11 +1593,16 @@ func defaultResolveTypeFn(p ResolveTypeParams, abstractType Abstract) *Object {
 			Info:    p.Info,
 			Context: p.Context,
 		}
-		if res := possibleType.IsTypeOf(isTypeOfParams); res {
-			return possibleType
+		if !possibleType.IsTypeOf(isTypeOfParams) {
+			continue
+		}
+		if runtimeType != nil && runtimeType != possibleType {
+			return nil, fmt.Errorf(`Abstract type "%v" is ambiguous for field "%v.%v" with value "%v", `+
+				`both "%v" and "%v" match it.`, abstractType, p.Info.ParentType, p.Info.FieldName, p.Value, runtimeType, possibleType)
 		}
+		runtimeType = possibleType
 	}
-	return nil
+	return runtimeType, nil
 }
 
 // FieldResolver is used in DefaultResolveFn when the the source value implements this interface.
@@ -976,6 +1656,11 @@ func DefaultResolveFn(p ResolveParams) (interface{}, error) {
 				continue
 			}
 		}
+		// fall back to a getter, e.g. for the oneof fields of protocol
+		// buffer messages, which are not struct fields of their own
+		if value, ok := resolveGetter(reflect.ValueOf(p.Source), p.Info.FieldName); ok {
+			return value, nil
+		}
 		return nil, nil
 	}
 
@@ -993,9 +1678,11 @@ func DefaultResolveFn(p ResolveParams) (interface{}, error) {
 		return property, nil
 	}
 
-	// Try accessing as map via reflection
+	// Try accessing as map via reflection, converting the field name to the
+	// key type, e.g. a named string type. Maps with keys of any other kind can
+	// never hold a field, as field names are not numbers.
 	if r := reflect.ValueOf(p.Source); r.Kind() == reflect.Map && r.Type().Key().Kind() == reflect.String {
-		val := r.MapIndex(reflect.ValueOf(p.Info.FieldName))
+		val := r.MapIndex(reflect.ValueOf(p.Info.FieldName).Convert(r.Type().Key()))
 		if val.IsValid() {
 			property := val.Interface()
 			if val.Type().Kind() == reflect.Func {
@@ -1013,6 +1700,24 @@ func DefaultResolveFn(p ResolveParams) (interface{}, error) {
 	return nil, nil
 }
 
+// resolveGetter calls the getter of a field on the source, named as in code
+// generated for protocol buffers: GetFirstName for both firstName and
+// first_name. Only methods taking no argument and returning a single value
+// are considered getters. ok is false if the source has no such getter.
+func resolveGetter(source reflect.Value, fieldName string) (value interface{}, ok bool) {
+	name := "Get"
+	for _, part := range strings.Split(fieldName, "_") {
+		if part != "" {
+			name += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	method := source.MethodByName(name)
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil, false
+	}
+	return method.Call(nil)[0].Interface(), true
+}
+
 // This method looks up the field on the given type definition.
 // It has special casing for the two introspection fields, __schema
 // and __typename. __typename is special because it can always be
@@ -1039,39 +1744,3 @@ func getFieldDef(schema Schema, parentType *Object, fieldName string) *FieldDefi
 	}
 	return parentType.Fields()[fieldName]
 }
-
-// contains field information that will be placed in an ordered slice
-type orderedField struct {
-	responseName string
-	fieldASTs    []*ast.Field
-}
-
-// orders fields from a fields map by location in the source
-func orderedFields(fields map[string][]*ast.Field) []*orderedField {
-	orderedFields := []*orderedField{}
-	fieldMap := map[int]*orderedField{}
-	startLocs := []int{}
-
-	for responseName, fieldASTs := range fields {
-		// find the lowest location in the current fieldASTs
-		lowest := -1
-		for _, fieldAST := range fieldASTs {
-			loc := fieldAST.GetLoc().Start
-			if lowest == -1 || loc < lowest {
-				lowest = loc
-			}
-		}
-		startLocs = append(startLocs, lowest)
-		fieldMap[lowest] = &orderedField{
-			responseName: responseName,
-			fieldASTs:    fieldASTs,
-		}
-	}
-
-	sort.Ints(startLocs)
-	for _, startLoc := range startLocs {
-		orderedFields = append(orderedFields, fieldMap[startLoc])
-	}
-
-	return orderedFields
-}
@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func TestExecuteOperation_StopsResolvingFieldsOnceTheContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resolved := []string{}
+	schema, err := NewSchema(SchemaConfig{
+		Query: NewObject(ObjectConfig{
+			Name: "Query",
+			Fields: Fields{
+				"hello": &Field{Type: String},
+			},
+		}),
+		Mutation: NewObject(ObjectConfig{
+			Name: "Mutation",
+			Fields: Fields{
+				"first": &Field{
+					Type: String,
+					Resolve: func(p ResolveParams) (interface{}, error) {
+						resolved = append(resolved, "first")
+						cancel()
+						return "first", nil
+					},
+				},
+				"second": &Field{
+					Type: String,
+					Resolve: func(p ResolveParams) (interface{}, error) {
+						resolved = append(resolved, "second")
+						return "second", nil
+					},
+				},
+				"third": &Field{
+					Type: String,
+					Resolve: func(p ResolveParams) (interface{}, error) {
+						resolved = append(resolved, "third")
+						return "third", nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	AST, err := parser.Parse(parser.ParseParams{Source: `mutation { first second third }`})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	eCtx, err := buildExecutionContext(buildExecutionCtxParams{
+		Schema:  schema,
+		AST:     AST,
+		Context: ctx,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := executeOperation(executeOperationParams{
+		ExecutionContext: eCtx,
+		Operation:        eCtx.Operation,
+	})
+
+	expectedData := map[string]interface{}{
+		"first":  "first",
+		"second": nil,
+		"third":  nil,
+	}
+	if !reflect.DeepEqual(expectedData, result.Data) {
+		t.Fatalf("expected data %v, got %v", expectedData, result.Data)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Message != context.Canceled.Error() ||
+		!reflect.DeepEqual(result.Errors[0].Path, []interface{}{"second"}) {
+		t.Fatalf("expected a single cancellation error for the second field, got %v", result.Errors)
+	}
+	if !reflect.DeepEqual(resolved, []string{"first"}) {
+		t.Fatalf("expected only the first field to be resolved, got %v", resolved)
+	}
+}
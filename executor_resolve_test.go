@@ -2,10 +2,16 @@ package graphql_test
 
 import (
 	"encoding/json"
-	"github.com/graphql-go/graphql"
-	"github.com/graphql-go/graphql/testutil"
+	"errors"
 	"reflect"
+	"sort"
 	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/testutil"
 )
 
 func testSchema(t *testing.T, testField *graphql.Field) graphql.Schema {
@@ -264,3 +270,256 @@ func TestExecutesResolveFunction_UsesProvidedResolveFunction_SourceIsStruct_With
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Data))
 	}
 }
+
+func TestExecutesResolveFunction_ReportsErrorsReturnedAlongsideValues(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"failing": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "ignored", errors.New("resolver failed")
+					},
+				},
+				"legacy": &graphql.Field{
+					Type: graphql.String,
+				},
+				"legacyFailing": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Invalid schema: %v", err)
+	}
+	// functions of the source returning only a value are still supported by
+	// the default resolver, as are functions also returning an error.
+	source := map[string]interface{}{
+		"legacy": func() interface{} {
+			return "legacyValue"
+		},
+		"legacyFailing": func() (interface{}, error) {
+			return nil, errors.New("legacy function failed")
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ failing legacy legacyFailing }`,
+		RootObject:    source,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"failing":       nil,
+			"legacy":        "legacyValue",
+			"legacyFailing": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "legacy function failed",
+				Locations: []location.SourceLocation{{Line: 1, Column: 18}},
+				Path:      []interface{}{"legacyFailing"},
+			},
+			{
+				Message:   "resolver failed",
+				Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+				Path:      []interface{}{"failing"},
+			},
+		},
+	}
+	sort.Sort(gqlerrors.FormattedErrors(result.Errors))
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestExecutesResolveFunction_InfoDescribesTheRequestedSubfields(t *testing.T) {
+	row := map[string]interface{}{
+		"id":    "1",
+		"name":  "Ada",
+		"email": "ada@example.com",
+		"bio":   "Countess",
+	}
+	var (
+		columns   []string
+		path      []interface{}
+		operation string
+	)
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":    &graphql.Field{Type: graphql.String},
+			"name":  &graphql.Field{Type: graphql.String},
+			"email": &graphql.Field{Type: graphql.String},
+			"bio":   &graphql.Field{Type: graphql.String},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: userType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						// only load the columns of the fields selected on the user
+						var collect func(selectionSet *ast.SelectionSet)
+						collect = func(selectionSet *ast.SelectionSet) {
+							for _, selection := range selectionSet.Selections {
+								switch selection := selection.(type) {
+								case *ast.Field:
+									columns = append(columns, selection.Name.Value)
+								case *ast.FragmentSpread:
+									collect(p.Info.Fragments[selection.Name.Value].GetSelectionSet())
+								}
+							}
+						}
+						for _, fieldAST := range p.Info.FieldASTs {
+							collect(fieldAST.SelectionSet)
+						}
+						sort.Strings(columns)
+						path = p.Info.Path.AsArray()
+						operation = p.Info.Operation.(*ast.OperationDefinition).Name.Value
+
+						user := map[string]interface{}{}
+						for _, column := range columns {
+							user[column] = row[column]
+						}
+						return user, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `
+          query Profile {
+            user {
+              id
+              ...Contact
+            }
+          }
+          fragment Contact on User {
+            name
+            email
+          }
+        `,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"user": map[string]interface{}{
+				"id":    "1",
+				"name":  "Ada",
+				"email": "ada@example.com",
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if expected := []string{"email", "id", "name"}; !reflect.DeepEqual(expected, columns) {
+		t.Fatalf("Unexpected columns, Diff: %v", testutil.Diff(expected, columns))
+	}
+	if expected := []interface{}{"user"}; !reflect.DeepEqual(expected, path) {
+		t.Fatalf("Unexpected path, Diff: %v", testutil.Diff(expected, path))
+	}
+	if operation != "Profile" {
+		t.Fatalf("Unexpected operation %q", operation)
+	}
+}
+
+// protoContact mimics a message generated for protocol buffers, of which the
+// oneof fields are only reachable through getters.
+type protoContact struct {
+	Name   string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Method isProtoContactMethod `protobuf_oneof:"method"`
+}
+
+type isProtoContactMethod interface {
+	isProtoContactMethod()
+}
+
+type protoContactEmail struct {
+	Email string `protobuf:"bytes,2,opt,name=email,proto3,oneof"`
+}
+
+type protoContactPhoneNumber struct {
+	PhoneNumber string `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3,oneof"`
+}
+
+func (*protoContactEmail) isProtoContactMethod()       {}
+func (*protoContactPhoneNumber) isProtoContactMethod() {}
+
+func (m *protoContact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *protoContact) GetEmail() string {
+	if x, ok := m.Method.(*protoContactEmail); ok {
+		return x.Email
+	}
+	return ""
+}
+
+func (m *protoContact) GetPhoneNumber() string {
+	if x, ok := m.Method.(*protoContactPhoneNumber); ok {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func TestExecutesResolveFunction_DefaultFunctionCallsProtobufGetters(t *testing.T) {
+	contactType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Contact",
+		Fields: graphql.Fields{
+			"name":         &graphql.Field{Type: graphql.String},
+			"email":        &graphql.Field{Type: graphql.String},
+			"phoneNumber":  &graphql.Field{Type: graphql.String},
+			"phone_number": &graphql.Field{Type: graphql.String},
+		},
+	})
+	schema := testSchema(t, &graphql.Field{
+		Type: graphql.NewList(contactType),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return []*protoContact{
+				{Name: "Ada", Method: &protoContactEmail{Email: "ada@example.com"}},
+				{Name: "Bob", Method: &protoContactPhoneNumber{PhoneNumber: "555-0100"}},
+			}, nil
+		},
+	})
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"test": []interface{}{
+				map[string]interface{}{
+					"name":         "Ada",
+					"email":        "ada@example.com",
+					"phoneNumber":  "",
+					"phone_number": "",
+				},
+				map[string]interface{}{
+					"name":         "Bob",
+					"email":        "",
+					"phoneNumber":  "555-0100",
+					"phone_number": "555-0100",
+				},
+			},
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ test { name email phoneNumber phone_number } }`,
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -353,6 +357,64 @@ func TestCustomMapType(t *testing.T) {
 	}
 }
 
+type customKey string
+
+func TestMapsWithNonStringKeys(t *testing.T) {
+	query := `
+		query Example { byInt { a } byKey { a } }
+	`
+	dataType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Data",
+		Fields: graphql.Fields{
+			"a": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "RootQuery",
+			Fields: graphql.Fields{
+				"byInt": &graphql.Field{
+					Type: dataType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return map[int]string{1: "1"}, nil
+					},
+				},
+				"byKey": &graphql.Field{
+					Type: dataType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return map[customKey]string{"a": "1"}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := testutil.TestExecute(t, graphql.ExecuteParams{
+		Schema: schema,
+		AST:    testutil.TestParse(t, query),
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+
+	expected := map[string]interface{}{
+		"byInt": map[string]interface{}{
+			"a": nil,
+		},
+		"byKey": map[string]interface{}{
+			"a": "1",
+		},
+	}
+	if !reflect.DeepEqual(result.Data, expected) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Data))
+	}
+}
+
 func TestThreadsSourceCorrectly(t *testing.T) {
 
 	query := `
@@ -950,42 +1012,80 @@ func TestThrowsIfOperationTypeIsUnsupported(t *testing.T) {
 		}
 	}
 }
-func TestUsesTheQuerySchemaForQueries(t *testing.T) {
 
-	doc := `query Q { a } mutation M { c } subscription S { a }`
-	data := map[string]interface{}{
-		"a": "b",
-		"c": "d",
+func TestRejectsOperationsSelectingMoreThanMaxRootFields(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{Type: graphql.String},
+				"b": &graphql.Field{Type: graphql.String},
+				"c": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	root := map[string]interface{}{
+		"a": "a",
+		"b": "b",
+		"c": "c",
 	}
 
+	// repeated selections of the same field are merged and count once
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ a a b }`,
+		RootObject:    root,
+		MaxRootFields: 2,
+	})
 	expected := &graphql.Result{
 		Data: map[string]interface{}{
-			"a": "b",
+			"a": "a",
+			"b": "b",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ a b other: c }`,
+		RootObject:    root,
+		MaxRootFields: 2,
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message:   "Operation selects 3 root fields, which exceeds the maximum of 2.",
+			Locations: []location.SourceLocation{{Line: 1, Column: 1}},
 		},
 	}
+	if result.Data != nil {
+		t.Fatalf("wrong result, expected nil result.Data, got %v", result.Data)
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+}
 
+func TestResolverPanicsAreReportedAsFieldErrorsUnlessRecoverIsDisabled(t *testing.T) {
 	schema, err := graphql.NewSchema(graphql.SchemaConfig{
 		Query: graphql.NewObject(graphql.ObjectConfig{
-			Name: "Q",
-			Fields: graphql.Fields{
-				"a": &graphql.Field{
-					Type: graphql.String,
-				},
-			},
-		}),
-		Mutation: graphql.NewObject(graphql.ObjectConfig{
-			Name: "M",
+			Name: "Query",
 			Fields: graphql.Fields{
-				"c": &graphql.Field{
+				"panics": &graphql.Field{
 					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						panic("resolver panicked")
+					},
 				},
-			},
-		}),
-		Subscription: graphql.NewObject(graphql.ObjectConfig{
-			Name: "S",
-			Fields: graphql.Fields{
-				"a": &graphql.Field{
+				"sibling": &graphql.Field{
 					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "sibling", nil
+					},
 				},
 			},
 		}),
@@ -994,53 +1094,72 @@ func TestUsesTheQuerySchemaForQueries(t *testing.T) {
 		t.Fatalf("Error in schema %v", err.Error())
 	}
 
-	// parse query
-	ast := testutil.TestParse(t, doc)
-
-	// execute
-	ep := graphql.ExecuteParams{
+	result := graphql.Do(graphql.Params{
 		Schema:        schema,
-		AST:           ast,
-		Root:          data,
-		OperationName: "Q",
-	}
-	result := testutil.TestExecute(t, ep)
-	if len(result.Errors) > 0 {
-		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+		RequestString: `{ panics sibling }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"panics":  nil,
+			"sibling": "sibling",
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "resolver panicked",
+				Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+				Path:      []interface{}{"panics"},
+			},
+		},
 	}
-	if !reflect.DeepEqual(expected, result) {
+	if !testutil.EqualResults(expected, result) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
-}
 
-func TestUsesTheMutationSchemaForMutations(t *testing.T) {
-
-	doc := `query Q { a } mutation M { c }`
-	data := map[string]interface{}{
-		"a": "b",
-		"c": "d",
-	}
+	defer func() {
+		r, ok := recover().(graphql.ResolverPanic)
+		if !ok || r.Value != "resolver panicked" {
+			t.Fatalf("expected the resolver panic to be raised again, got %v", r)
+		}
+		// the stack is the one of the resolver, not of the goroutine calling Do
+		if !strings.Contains(string(r.Stack), "TestResolverPanicsAreReportedAsFieldErrorsUnlessRecoverIsDisabled.func1") {
+			t.Fatalf("expected the stack of the resolver, got %s", r.Stack)
+		}
+	}()
+	graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `{ panics sibling }`,
+		DisableRecover: true,
+	})
+	t.Fatalf("expected the resolver panic to escape")
+}
 
-	expected := &graphql.Result{
-		Data: map[string]interface{}{
-			"c": "d",
+func TestAbortsExecutionResolvingMoreThanMaxNodes(t *testing.T) {
+	resolvedIDs := 0
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					resolvedIDs++
+					return p.Source, nil
+				},
+			},
 		},
-	}
-
+	})
 	schema, err := graphql.NewSchema(graphql.SchemaConfig{
 		Query: graphql.NewObject(graphql.ObjectConfig{
-			Name: "Q",
-			Fields: graphql.Fields{
-				"a": &graphql.Field{
-					Type: graphql.String,
-				},
-			},
-		}),
-		Mutation: graphql.NewObject(graphql.ObjectConfig{
-			Name: "M",
+			Name: "Query",
 			Fields: graphql.Fields{
-				"c": &graphql.Field{
-					Type: graphql.String,
+				"items": &graphql.Field{
+					Type: graphql.NewList(itemType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						items := make([]int, 100)
+						for i := range items {
+							items[i] = i
+						}
+						return items, nil
+					},
 				},
 			},
 		}),
@@ -1049,71 +1168,403 @@ func TestUsesTheMutationSchemaForMutations(t *testing.T) {
 		t.Fatalf("Error in schema %v", err.Error())
 	}
 
-	// parse query
-	ast := testutil.TestParse(t, doc)
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ items { id } }`,
+		MaxNodes:      100,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	if items := result.Data.(map[string]interface{})["items"].([]interface{}); len(items) != 100 {
+		t.Fatalf("expected 100 items, got %v", len(items))
+	}
 
-	// execute
-	ep := graphql.ExecuteParams{
+	resolvedIDs = 0
+	result = graphql.Do(graphql.Params{
 		Schema:        schema,
-		AST:           ast,
-		Root:          data,
-		OperationName: "M",
+		RequestString: `{ items { id } }`,
+		MaxNodes:      50,
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message:   "Operation resolves more than the maximum of 50 nodes.",
+			Locations: []location.SourceLocation{{Line: 1, Column: 1}},
+		},
 	}
-	result := testutil.TestExecute(t, ep)
-	if len(result.Errors) > 0 {
-		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	if result.Data != nil {
+		t.Fatalf("wrong result, expected nil result.Data, got %v", result.Data)
 	}
-	if !reflect.DeepEqual(expected, result) {
-		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+	if resolvedIDs != 50 {
+		t.Fatalf("expected fields of 50 items to be resolved, got %v", resolvedIDs)
 	}
 }
 
-func TestUsesTheSubscriptionSchemaForSubscriptions(t *testing.T) {
-
-	doc := `query Q { a } subscription S { a }`
-	data := map[string]interface{}{
-		"a": "b",
-		"c": "d",
+func TestRejectsFragmentsNestedDeeperThanMaxFragmentDepth(t *testing.T) {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"item": &graphql.Field{Type: itemType},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	root := map[string]interface{}{
+		"item": map[string]interface{}{
+			"name": "a",
+		},
 	}
+	query := `{ item { ...first } }
+		fragment first on Item { ...second }
+		fragment second on Item { ... on Item { name } }`
 
+	// fragments nested exactly as deep as the limit are expanded
+	result := graphql.Do(graphql.Params{
+		Schema:           schema,
+		RequestString:    query,
+		RootObject:       root,
+		MaxFragmentDepth: 3,
+	})
 	expected := &graphql.Result{
 		Data: map[string]interface{}{
-			"a": "b",
+			"item": map[string]interface{}{
+				"name": "a",
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:           schema,
+		RequestString:    query,
+		RootObject:       root,
+		MaxFragmentDepth: 2,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"item": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "Fragments are nested deeper than the maximum depth of 2.",
+				Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+				Path:      []interface{}{"item"},
+			},
 		},
 	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
 
+func TestNonFiniteFloatsAreSerializedAccordingToThePolicy(t *testing.T) {
 	schema, err := graphql.NewSchema(graphql.SchemaConfig{
 		Query: graphql.NewObject(graphql.ObjectConfig{
-			Name: "Q",
-			Fields: graphql.Fields{
-				"a": &graphql.Field{
-					Type: graphql.String,
-				},
-			},
-		}),
-		Subscription: graphql.NewObject(graphql.ObjectConfig{
-			Name: "S",
+			Name: "Query",
 			Fields: graphql.Fields{
-				"a": &graphql.Field{
-					Type: graphql.String,
-				},
+				"nan": &graphql.Field{Type: graphql.Float},
+				"inf": &graphql.Field{Type: graphql.Float},
 			},
 		}),
 	})
 	if err != nil {
 		t.Fatalf("Error in schema %v", err.Error())
 	}
+	root := map[string]interface{}{
+		"nan": math.NaN(),
+		"inf": math.Inf(1),
+	}
 
-	// parse query
-	ast := testutil.TestParse(t, doc)
-
-	// execute
-	ep := graphql.ExecuteParams{
+	result := graphql.Do(graphql.Params{
 		Schema:        schema,
-		AST:           ast,
-		Root:          data,
-		OperationName: "S",
-	}
+		RequestString: `{ nan inf }`,
+		RootObject:    root,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"nan": nil,
+			"inf": nil,
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if _, err := json.Marshal(result); err != nil {
+		t.Fatalf("expected the result to be JSON encodable, got %v", err)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:          schema,
+		RequestString:   `{ nan }`,
+		RootObject:      root,
+		NonFiniteFloats: graphql.NonFiniteFloatAsError,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"nan": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "Float cannot represent non-finite value: NaN",
+				Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+				Path:      []interface{}{"nan"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestCoerceArgsCanRejectInvalidArgumentCombinations(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"range": &graphql.Field{
+					Type: graphql.NewList(graphql.Int),
+					Args: graphql.FieldConfigArgument{
+						"min": &graphql.ArgumentConfig{Type: graphql.Int},
+						"max": &graphql.ArgumentConfig{Type: graphql.Int},
+					},
+					CoerceArgs: func(args map[string]interface{}) (map[string]interface{}, error) {
+						min, _ := args["min"].(int)
+						max, ok := args["max"].(int)
+						if !ok {
+							max = min
+						}
+						if min > max {
+							return nil, errors.New("min must not exceed max")
+						}
+						return map[string]interface{}{"min": min, "max": max}, nil
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						values := []interface{}{}
+						for i := p.Args["min"].(int); i <= p.Args["max"].(int); i++ {
+							values = append(values, i)
+						}
+						return values, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ range(min: 2) }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"range": []interface{}{2},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ range(min: 3, max: 1) }`,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"range": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "min must not exceed max",
+				Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+				Path:      []interface{}{"range"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestUsesTheQuerySchemaForQueries(t *testing.T) {
+
+	doc := `query Q { a } mutation M { c } subscription S { a }`
+	data := map[string]interface{}{
+		"a": "b",
+		"c": "d",
+	}
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"a": "b",
+		},
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Q",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "M",
+			Fields: graphql.Fields{
+				"c": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+		Subscription: graphql.NewObject(graphql.ObjectConfig{
+			Name: "S",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// parse query
+	ast := testutil.TestParse(t, doc)
+
+	// execute
+	ep := graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           ast,
+		Root:          data,
+		OperationName: "Q",
+	}
+	result := testutil.TestExecute(t, ep)
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestUsesTheMutationSchemaForMutations(t *testing.T) {
+
+	doc := `query Q { a } mutation M { c }`
+	data := map[string]interface{}{
+		"a": "b",
+		"c": "d",
+	}
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"c": "d",
+		},
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Q",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "M",
+			Fields: graphql.Fields{
+				"c": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// parse query
+	ast := testutil.TestParse(t, doc)
+
+	// execute
+	ep := graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           ast,
+		Root:          data,
+		OperationName: "M",
+	}
+	result := testutil.TestExecute(t, ep)
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestUsesTheSubscriptionSchemaForSubscriptions(t *testing.T) {
+
+	doc := `query Q { a } subscription S { a }`
+	data := map[string]interface{}{
+		"a": "b",
+		"c": "d",
+	}
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"a": "b",
+		},
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Q",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+		Subscription: graphql.NewObject(graphql.ObjectConfig{
+			Name: "S",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// parse query
+	ast := testutil.TestParse(t, doc)
+
+	// execute
+	ep := graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           ast,
+		Root:          data,
+		OperationName: "S",
+	}
 	result := testutil.TestExecute(t, ep)
 	if len(result.Errors) > 0 {
 		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
@@ -1910,34 +2361,169 @@ func TestContextDeadline(t *testing.T) {
 	}
 }
 
-func TestThunkResultsProcessedCorrectly(t *testing.T) {
-	barType := graphql.NewObject(graphql.ObjectConfig{
-		Name: "Bar",
-		Fields: graphql.Fields{
-			"bazA": &graphql.Field{
-				Type: graphql.String,
-			},
-			"bazB": &graphql.Field{
-				Type: graphql.String,
-			},
-		},
-	})
-
-	fooType := graphql.NewObject(graphql.ObjectConfig{
-		Name: "Foo",
-		Fields: graphql.Fields{
-			"bar": &graphql.Field{
-				Type: barType,
-				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
-					var bar struct {
-						BazA string
-						BazB string
-					}
-					bar.BazA = "A"
-					bar.BazB = "B"
+func TestContextCancelledReturnsTheRootFieldsCompletedSoFar(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
 
-					thunk := func() (interface{}, error) { return &bar, nil }
-					return thunk, nil
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"hello": &graphql.Field{Type: graphql.String},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"first": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "first", nil
+					},
+				},
+				// second does not return when the context is done
+				"second": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						close(started)
+						<-release
+						return "second", nil
+					},
+				},
+				"third": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "third", nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error, got: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `mutation { first second third }`,
+		Context:       ctx,
+	})
+
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"first":  "first",
+			"second": nil,
+			"third":  nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   context.Canceled.Error(),
+				Locations: []location.SourceLocation{},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestContextCancelledNullsNonNullFieldsSkippedAfterIt(t *testing.T) {
+	var cancel context.CancelFunc
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"hello": &graphql.Field{Type: graphql.String},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						cancel()
+						return "a", nil
+					},
+				},
+				"b": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "b", nil
+					},
+				},
+				"c": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "c", nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error, got: %v", err)
+	}
+
+	// the execution races with the cancellation being noticed by Do, which
+	// must give the same result either way
+	expected := &graphql.Result{
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   context.Canceled.Error(),
+				Locations: []location.SourceLocation{},
+			},
+		},
+	}
+	for i := 0; i < 50; i++ {
+		ctx, cancelCtx := context.WithCancel(context.Background())
+		cancel = cancelCtx
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `mutation { a b c }`,
+			Context:       ctx,
+		})
+		cancelCtx()
+		if !testutil.EqualResults(expected, result) {
+			t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+		}
+	}
+}
+
+func TestThunkResultsProcessedCorrectly(t *testing.T) {
+	barType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Bar",
+		Fields: graphql.Fields{
+			"bazA": &graphql.Field{
+				Type: graphql.String,
+			},
+			"bazB": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+
+	fooType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Foo",
+		Fields: graphql.Fields{
+			"bar": &graphql.Field{
+				Type: barType,
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					var bar struct {
+						BazA string
+						BazB string
+					}
+					bar.BazA = "A"
+					bar.BazB = "B"
+
+					thunk := func() (interface{}, error) { return &bar, nil }
+					return thunk, nil
 				},
 			},
 		},
@@ -2102,6 +2688,71 @@ func TestThunkErrorsAreHandledCorrectly(t *testing.T) {
 	}
 }
 
+func TestThunksTakingAContextAreGivenTheRequestContext(t *testing.T) {
+	type userKey struct{}
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"viewer": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return func(ctx context.Context) (interface{}, error) {
+						return ctx.Value(userKey{}), nil
+					}, nil
+				},
+			},
+			"greetings": &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					greet := func(greeting string) func(context.Context) (interface{}, error) {
+						return func(ctx context.Context) (interface{}, error) {
+							return fmt.Sprintf("%v, %v", greeting, ctx.Value(userKey{})), nil
+						}
+					}
+					return []interface{}{greet("Hello"), greet("Bye")}, nil
+				},
+			},
+			"denied": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return func(ctx context.Context) (interface{}, error) {
+						return nil, fmt.Errorf("%v may not see this", ctx.Value(userKey{}))
+					}, nil
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error, got: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: "{ viewer greetings denied }",
+		Context:       context.WithValue(context.Background(), userKey{}, "ada"),
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"viewer":    "ada",
+			"greetings": []interface{}{"Hello, ada", "Bye, ada"},
+			"denied":    nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "ada may not see this",
+				Locations: []location.SourceLocation{{Line: 1, Column: 20}},
+				Path:      []interface{}{"denied"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func assertJSON(t *testing.T, expected string, actual interface{}) {
 	var e interface{}
 	if err := json.Unmarshal([]byte(expected), &e); err != nil {
@@ -2260,6 +2911,85 @@ func TestQuery_ErrorPath(t *testing.T) {
 	}`, result)
 }
 
+func TestQuery_ErrorPathUsesResponseNamesAndListIndices(t *testing.T) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					name := p.Source.(map[string]interface{})["name"]
+					if name == nil {
+						return nil, errors.New("name is unavailable")
+					}
+					return name, nil
+				},
+			},
+		},
+	})
+	userType.AddFieldConfig("friends", &graphql.Field{Type: graphql.NewList(userType)})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{Type: userType},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	root := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name": "Alice",
+			"friends": []interface{}{
+				map[string]interface{}{"name": "Bob"},
+				map[string]interface{}{"name": "Carol"},
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { friends { name } buddies: friends { alias: name } } }`,
+		RootObject:    root,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"user": map[string]interface{}{
+				"friends": []interface{}{
+					map[string]interface{}{"name": "Bob"},
+					map[string]interface{}{"name": "Carol"},
+					map[string]interface{}{"name": nil},
+				},
+				"buddies": []interface{}{
+					map[string]interface{}{"alias": "Bob"},
+					map[string]interface{}{"alias": "Carol"},
+					map[string]interface{}{"alias": nil},
+				},
+			},
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "name is unavailable",
+				Locations: []location.SourceLocation{{Line: 1, Column: 20}},
+				Path:      []interface{}{"user", "friends", 2, "name"},
+			},
+			{
+				Message:   "name is unavailable",
+				Locations: []location.SourceLocation{{Line: 1, Column: 46}},
+				Path:      []interface{}{"user", "buddies", 2, "alias"},
+			},
+		},
+	}
+	sort.Sort(gqlerrors.FormattedErrors(expected.Errors))
+	sort.Sort(gqlerrors.FormattedErrors(result.Errors))
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 // http://facebook.github.io/graphql/June2018/#example-08b62
 func TestQuery_ErrorPathForNonNullField(t *testing.T) {
 	result := testErrors(t, graphql.NewNonNull(graphql.String), nil, nil)
@@ -2328,6 +3058,30 @@ func TestQuery_ErrorExtensions(t *testing.T) {
 	}`, result)
 }
 
+func TestQuery_ErrorsMarshalWithAStableKeyOrder(t *testing.T) {
+	result := testErrors(t, graphql.NewNonNull(graphql.String), map[string]interface{}{
+		"timestamp": "Fri Feb 9 14:33:09 UTC 2018",
+		"code":      "CAN_NOT_FETCH_BY_ID",
+	}, nil)
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected a single error, got %v", result.Errors)
+	}
+
+	expected := `{"message":"Name for character with ID 1002 could not be fetched.",` +
+		`"locations":[{"line":6,"column":7}],` +
+		`"path":["hero","heroFriends",1,"name"],` +
+		`"extensions":{"code":"CAN_NOT_FETCH_BY_ID","timestamp":"Fri Feb 9 14:33:09 UTC 2018"}}`
+	for i := 0; i < 10; i++ {
+		b, err := json.Marshal(result.Errors[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(b) != expected {
+			t.Fatalf("Unexpected JSON, expected:\n%v\ngot:\n%v", expected, string(b))
+		}
+	}
+}
+
 func TestQuery_OriginalErrorBuiltin(t *testing.T) {
 	result := testErrors(t, graphql.String, nil, nil)
 	switch err := result.Errors[0].OriginalError().(type) {
@@ -2414,3 +3168,371 @@ func TestQuery_OriginalErrorPanic(t *testing.T) {
 		t.Fatalf("unexpected error: %v", reflect.TypeOf(err))
 	}
 }
+
+func TestQueryingADeprecatedFieldReportsAWarning(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"name": &graphql.Field{
+					Type: graphql.String,
+				},
+				"nickname": &graphql.Field{
+					Type:              graphql.String,
+					DeprecationReason: "Use name.",
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	root := map[string]interface{}{
+		"name":     "Luke",
+		"nickname": "Lucky",
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ name }`,
+		RootObject:    root,
+	})
+	if len(result.Warnings) != 0 {
+		t.Fatalf("expected no warnings, got %v", result.Warnings)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ name nickname }`,
+		RootObject:    root,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"name":     "Luke",
+			"nickname": "Lucky",
+		},
+		Warnings: []gqlerrors.FormattedError{
+			{
+				Message:   `The field "Query.nickname" is deprecated. Use name.`,
+				Locations: []location.SourceLocation{{Line: 1, Column: 8}},
+				Path:      []interface{}{"nickname"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestDeprecatedFieldWarningsReferenceTheFieldPathAndReason(t *testing.T) {
+	personType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Person",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"age": &graphql.Field{
+				Type:              graphql.Int,
+				DeprecationReason: "Use birthday.",
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"people": &graphql.Field{
+					Type: graphql.NewList(personType),
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+			people {
+				name
+				age
+			}
+		}`,
+		RootObject: map[string]interface{}{
+			"people": []interface{}{
+				map[string]interface{}{"name": "Luke", "age": 19},
+				map[string]interface{}{"name": "Leia", "age": 19},
+			},
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"people": []interface{}{
+				map[string]interface{}{"name": "Luke", "age": 19},
+				map[string]interface{}{"name": "Leia", "age": 19},
+			},
+		},
+		Warnings: []gqlerrors.FormattedError{
+			{
+				Message:   `The field "Person.age" is deprecated. Use birthday.`,
+				Locations: []location.SourceLocation{{Line: 4, Column: 5}},
+				Path:      []interface{}{"people", 0, "age"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+type testLoaderKey struct{}
+
+// testLoader caches the values it loads, counting the loads that missed the
+// cache.
+type testLoader struct {
+	cache  map[string]interface{}
+	misses int
+}
+
+func (l *testLoader) load(key string) interface{} {
+	if value, ok := l.cache[key]; ok {
+		return value
+	}
+	l.misses++
+	l.cache[key] = "value of " + key
+	return l.cache[key]
+}
+
+func TestRequestScopedLoadersAreNotSharedAcrossRequests(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"item": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"key": &graphql.ArgumentConfig{Type: graphql.String},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						loader := p.Context.Value(testLoaderKey{}).(*testLoader)
+						return loader.load(p.Args["key"].(string)), nil
+					},
+				},
+				"legacyItem": &graphql.Field{
+					Type:              graphql.String,
+					DeprecationReason: "Use item.",
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	query := `{ a: item(key: "a") b: item(key: "a") c: item(key: "c") legacyItem }`
+
+	for i := 0; i < 2; i++ {
+		loader := &testLoader{cache: map[string]interface{}{}}
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: query,
+			Context:       context.WithValue(context.Background(), testLoaderKey{}, loader),
+		})
+		if len(result.Errors) > 0 {
+			t.Fatalf("unexpected errors: %v", result.Errors)
+		}
+		if loader.misses != 2 {
+			t.Fatalf("request %d: expected the loader to load 2 keys, loaded %d", i+1, loader.misses)
+		}
+		// warnings are tracked per request, so each request reports them
+		if len(result.Warnings) != 1 {
+			t.Fatalf("request %d: expected one deprecation warning, got %v", i+1, result.Warnings)
+		}
+	}
+}
+
+// testBatchLoader defers its loads until the value of one of them is needed,
+// then loads all the pending keys in a single batch.
+type testBatchLoader struct {
+	pending []string
+	loaded  map[string]interface{}
+	batches [][]string
+}
+
+func (l *testBatchLoader) load(key string) func() (interface{}, error) {
+	l.pending = append(l.pending, key)
+	return func() (interface{}, error) {
+		if _, ok := l.loaded[key]; !ok {
+			sort.Strings(l.pending)
+			for _, key := range l.pending {
+				l.loaded[key] = map[string]interface{}{"id": key}
+			}
+			l.batches = append(l.batches, l.pending)
+			l.pending = nil
+		}
+		return l.loaded[key], nil
+	}
+}
+
+func TestBatchThunksCoalescesLoadsAcrossLevels(t *testing.T) {
+	var loader *testBatchLoader
+	nodeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.String},
+		},
+	})
+	nodeType.AddFieldConfig("child", &graphql.Field{
+		Type: nodeType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return loader.load(p.Source.(map[string]interface{})["id"].(string) + ".child"), nil
+		},
+	})
+	holderType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Holder",
+		Fields: graphql.Fields{
+			"c": &graphql.Field{
+				Type: nodeType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loader.load("c"), nil
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{
+					Type: nodeType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loader.load("a"), nil
+					},
+				},
+				"b": &graphql.Field{
+					Type: holderType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return map[string]interface{}{}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// a.child is one level shallower than b.c.child, but both are only waiting
+	// on the first batch, so they are loaded together.
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"a": map[string]interface{}{
+				"child": map[string]interface{}{"id": "a.child"},
+			},
+			"b": map[string]interface{}{
+				"c": map[string]interface{}{
+					"child": map[string]interface{}{"id": "c.child"},
+				},
+			},
+		},
+	}
+	expectedBatches := [][]string{
+		{"a", "c"},
+		{"a.child", "c.child"},
+	}
+	for i := 0; i < 10; i++ {
+		loader = &testBatchLoader{loaded: map[string]interface{}{}}
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ a { child { id } } b { c { child { id } } } }`,
+			BatchThunks:   true,
+		})
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+		}
+		if !reflect.DeepEqual(expectedBatches, loader.batches) {
+			t.Fatalf("Unexpected batches, Diff: %v", testutil.Diff(expectedBatches, loader.batches))
+		}
+	}
+}
+
+func TestMaxConcurrencyResolvesSiblingFieldsConcurrently(t *testing.T) {
+	var running, maxRunning int32
+	delayed := func(value interface{}) graphql.FieldResolveFn {
+		return func(p graphql.ResolveParams) (interface{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			return value, nil
+		}
+	}
+	failing := func(p graphql.ResolveParams) (interface{}, error) {
+		return nil, errors.New("failed")
+	}
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"a":      &graphql.Field{Type: graphql.String, Resolve: delayed("a")},
+			"b":      &graphql.Field{Type: graphql.String, Resolve: delayed("b")},
+			"broken": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: failing},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a":      &graphql.Field{Type: graphql.String, Resolve: delayed("a")},
+				"b":      &graphql.Field{Type: graphql.String, Resolve: delayed("b")},
+				"c":      &graphql.Field{Type: graphql.String, Resolve: delayed("c")},
+				"item":   &graphql.Field{Type: itemType, Resolve: delayed(map[string]interface{}{})},
+				"broken": &graphql.Field{Type: itemType, Resolve: delayed(map[string]interface{}{})},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	query := `{ a b c item { a b } broken { a broken } }`
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"a":      "a",
+			"b":      "b",
+			"c":      "c",
+			"item":   map[string]interface{}{"a": "a", "b": "b"},
+			"broken": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "failed",
+				Locations: []location.SourceLocation{{Line: 1, Column: 33}},
+				Path:      []interface{}{"broken", "broken"},
+			},
+		},
+	}
+
+	for _, maxConcurrency := range []int{2, 8} {
+		atomic.StoreInt32(&maxRunning, 0)
+		start := time.Now()
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  query,
+			MaxConcurrency: maxConcurrency,
+		})
+		elapsed := time.Since(start)
+		if !testutil.EqualResults(expected, result) {
+			t.Fatalf("MaxConcurrency %v: Unexpected result, Diff: %v", maxConcurrency, testutil.Diff(expected, result))
+		}
+		if max := atomic.LoadInt32(&maxRunning); max > int32(maxConcurrency) || max < 2 {
+			t.Fatalf("MaxConcurrency %v: expected at most %v and at least 2 resolvers at once, got %v", maxConcurrency, maxConcurrency, max)
+		}
+		// resolved sequentially, the seven delayed resolvers take 350ms
+		if maxConcurrency == 8 && elapsed >= 200*time.Millisecond {
+			t.Fatalf("expected the fields to be resolved concurrently, took %v", elapsed)
+		}
+	}
+}
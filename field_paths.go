@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// QueryFieldPaths returns the path of every leaf field the operation of the
+// document selects, e.g. for checking field level access before executing
+// it. Paths are made of field names rather than aliases, fragments are
+// expanded whatever their type condition, and fields excluded by @skip or
+// @include for the given variables are left out. The operation is picked by
+// name as for Execute. Paths are sorted and each is returned once.
+func QueryFieldPaths(schema Schema, doc *ast.Document, operationName string, variables map[string]interface{}) ([][]string, error) {
+	eCtx, err := buildExecutionContext(buildExecutionCtxParams{
+		Schema:        schema,
+		AST:           doc,
+		OperationName: operationName,
+		Args:          variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+	paths := map[string][]string{}
+	collectFieldPaths(eCtx, eCtx.Operation.GetSelectionSet(), nil, map[string]bool{}, paths)
+
+	keys := []string{}
+	for key := range paths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, paths[key])
+	}
+	return result, nil
+}
+
+// collectFieldPaths adds the paths of the leaf fields of the selection set,
+// prefixed with the given path, to paths. The fragments being expanded are
+// tracked in visiting to stop on fragment cycles.
+func collectFieldPaths(eCtx *executionContext, selectionSet *ast.SelectionSet, prefix []string, visiting map[string]bool, paths map[string][]string) {
+	walkSelectedFields(eCtx, selectionSet, 0, visiting, func(field *ast.Field) {
+		if field.Name == nil {
+			return
+		}
+		path := make([]string, len(prefix), len(prefix)+1)
+		copy(path, prefix)
+		path = append(path, field.Name.Value)
+		if field.SelectionSet == nil {
+			paths[strings.Join(path, "\x00")] = path
+			return
+		}
+		collectFieldPaths(eCtx, field.SelectionSet, path, visiting, paths)
+	})
+}
+
+// walkSelectedFields calls fn with each field of the selection set that is not
+// excluded by @skip or @include, expanding fragments whatever their type
+// condition. Fragments are expanded once within the fragments being expanded,
+// which are tracked in visiting, so fragment cycles stop. depth is how deeply
+// the selection set is nested in fragments, and it panics as collectFields
+// does once that exceeds the MaxFragmentDepth of the execution.
+func walkSelectedFields(eCtx *executionContext, selectionSet *ast.SelectionSet, depth int, visiting map[string]bool, fn func(field *ast.Field)) {
+	if selectionSet == nil {
+		return
+	}
+	for _, iSelection := range selectionSet.Selections {
+		switch selection := iSelection.(type) {
+		case *ast.Field:
+			if !shouldIncludeNode(eCtx, selection.Directives) {
+				continue
+			}
+			fn(selection)
+		case *ast.InlineFragment:
+			if !shouldIncludeNode(eCtx, selection.Directives) {
+				continue
+			}
+			checkFragmentDepth(collectFieldsParams{ExeContext: eCtx, Depth: depth})
+			walkSelectedFields(eCtx, selection.SelectionSet, depth+1, visiting, fn)
+		case *ast.FragmentSpread:
+			if selection.Name == nil || !shouldIncludeNode(eCtx, selection.Directives) {
+				continue
+			}
+			fragName := selection.Name.Value
+			fragment, ok := eCtx.Fragments[fragName]
+			if !ok || visiting[fragName] {
+				continue
+			}
+			checkFragmentDepth(collectFieldsParams{ExeContext: eCtx, Depth: depth})
+			visiting[fragName] = true
+			walkSelectedFields(eCtx, fragment.GetSelectionSet(), depth+1, visiting, fn)
+			delete(visiting, fragName)
+		}
+	}
+}
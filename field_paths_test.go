@@ -0,0 +1,83 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestQueryFieldPaths_ReturnsTheLeafPathsOfANestedQuery(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query HeroAndLuke($withFriends: Boolean!) {
+        hero {
+          id
+          name
+          friends @include(if: $withFriends) {
+            name
+            ...Appearances
+          }
+          ... on Droid {
+            primaryFunction
+          }
+        }
+        luke: human(id: "1000") {
+          ...Appearances
+          homePlanet
+        }
+      }
+      fragment Appearances on Character {
+        name
+        appearsIn
+      }
+    `)
+
+	paths, err := graphql.QueryFieldPaths(testutil.StarWarsSchema, doc, "HeroAndLuke", map[string]interface{}{
+		"withFriends": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]string{
+		{"hero", "friends", "appearsIn"},
+		{"hero", "friends", "name"},
+		{"hero", "id"},
+		{"hero", "name"},
+		{"hero", "primaryFunction"},
+		{"human", "appearsIn"},
+		{"human", "homePlanet"},
+		{"human", "name"},
+	}
+	if !reflect.DeepEqual(expected, paths) {
+		t.Fatalf("Unexpected paths, Diff: %v", testutil.Diff(expected, paths))
+	}
+
+	paths, err = graphql.QueryFieldPaths(testutil.StarWarsSchema, doc, "HeroAndLuke", map[string]interface{}{
+		"withFriends": false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = [][]string{
+		{"hero", "id"},
+		{"hero", "name"},
+		{"hero", "primaryFunction"},
+		{"human", "appearsIn"},
+		{"human", "homePlanet"},
+		{"human", "name"},
+	}
+	if !reflect.DeepEqual(expected, paths) {
+		t.Fatalf("Unexpected paths, Diff: %v", testutil.Diff(expected, paths))
+	}
+}
+
+func TestQueryFieldPaths_ReportsUnknownOperations(t *testing.T) {
+	doc := testutil.TestParse(t, `query Hero { hero { name } }`)
+
+	_, err := graphql.QueryFieldPaths(testutil.StarWarsSchema, doc, "Villain", nil)
+	expected := `Unknown operation named "Villain".`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error: %v, got %v", expected, err)
+	}
+}
@@ -11,6 +11,9 @@ type ExtendedError interface {
 	Extensions() map[string]interface{}
 }
 
+// FormattedError is an error as reported in the result of a request. Its
+// fields are declared in the order they are marshaled to JSON: message,
+// locations, path and extensions.
 type FormattedError struct {
 	Message       string                    `json:"message"`
 	Locations     []location.SourceLocation `json:"locations"`
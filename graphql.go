@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/language/parser"
 	"github.com/graphql-go/graphql/language/source"
 )
@@ -19,6 +20,12 @@ type Params struct {
 	// level type (e.g. the query object type).
 	RootObject map[string]interface{}
 
+	// RootValue is provided as the source of the top level resolvers like
+	// RootObject, but may be of any type, e.g. a struct holding request-scoped
+	// loaders. It takes precedence over RootObject when set. It may be an
+	// OperationRoots to use a different root for each type of operation.
+	RootValue interface{}
+
 	// A mapping of variable name to runtime value to use for all variables
 	// defined in the requestString.
 	VariableValues map[string]interface{}
@@ -31,6 +38,54 @@ type Params struct {
 	// Context may be provided to pass application-specific per-request
 	// information to resolve functions.
 	Context context.Context
+
+	// MaxRootFields limits how many top-level fields a single operation may
+	// select; operations selecting more are rejected. Zero means no limit.
+	MaxRootFields int
+
+	// MaxFragmentDepth limits how deeply fragments may be nested within a
+	// selection set; fields nesting them deeper are reported as errors. Zero
+	// means no limit.
+	MaxFragmentDepth int
+
+	// MaxNodes limits how many objects a single operation may resolve;
+	// execution is aborted once more are resolved. Zero means no limit.
+	MaxNodes int
+
+	// BatchThunks resolves the thunks returned by resolvers in waves across
+	// the whole result rather than level by level, so a batching loader sees
+	// the loads made at every depth of the query at once.
+	BatchThunks bool
+
+	// MaxConcurrency resolves the fields of a selection set concurrently, with
+	// at most MaxConcurrency resolvers running at once; the root fields of
+	// mutations are still resolved serially. Zero or one resolves fields
+	// sequentially.
+	MaxConcurrency int
+
+	// DisableRecover lets panics raised by resolvers escape Do, rather than
+	// reporting them as field errors, which can help debugging them. The
+	// panic is raised again as a ResolverPanic. Subscribe ignores it.
+	DisableRecover bool
+
+	// NonFiniteFloats decides how NaN and infinite float values, which JSON
+	// can not represent, are serialized. They are serialized as null by
+	// default, or reported as field errors with NonFiniteFloatAsError.
+	NonFiniteFloats NonFiniteFloatPolicy
+
+	// DryRun parses and validates the request, then returns the shape of its
+	// response without calling any resolver, e.g. to preview the cost of a
+	// query. Objects are maps of the fields selected on them, whatever their
+	// type, leaf values are null, and lists have the shape of their items.
+	// MaxRootFields and MaxFragmentDepth are enforced; MaxNodes is not, as the
+	// number of objects is only known once resolvers return them.
+	DryRun bool
+
+	// ErrorOperationName adds the name of the executed operation to the
+	// extensions of the errors raised while executing it, under the
+	// "operationName" key, to correlate them with the request in logs.
+	// It applies to Do only; Subscribe ignores it.
+	ErrorOperationName bool
 }
 
 func Do(p Params) *Result {
@@ -105,12 +160,64 @@ func Do(p Params) *Result {
 		}
 	}
 
-	return Execute(ExecuteParams{
-		Schema:        p.Schema,
-		Root:          p.RootObject,
-		AST:           AST,
-		OperationName: p.OperationName,
-		Args:          p.VariableValues,
-		Context:       p.Context,
+	if p.DryRun {
+		return dryRun(buildExecutionCtxParams{
+			Schema:           p.Schema,
+			Root:             p.rootValue(),
+			AST:              AST,
+			OperationName:    p.OperationName,
+			Args:             p.VariableValues,
+			Context:          p.Context,
+			MaxRootFields:    p.MaxRootFields,
+			MaxFragmentDepth: p.MaxFragmentDepth,
+		})
+	}
+
+	result := Execute(ExecuteParams{
+		Schema:           p.Schema,
+		Root:             p.rootValue(),
+		AST:              AST,
+		OperationName:    p.OperationName,
+		Args:             p.VariableValues,
+		Context:          p.Context,
+		MaxRootFields:    p.MaxRootFields,
+		MaxFragmentDepth: p.MaxFragmentDepth,
+		MaxNodes:         p.MaxNodes,
+		BatchThunks:      p.BatchThunks,
+		MaxConcurrency:   p.MaxConcurrency,
+		DisableRecover:   p.DisableRecover,
+		NonFiniteFloats:  p.NonFiniteFloats,
 	})
+	if p.ErrorOperationName {
+		addErrorOperationName(result, AST, p.OperationName)
+	}
+	return result
+}
+
+// addErrorOperationName adds the name of the operation of the document picked
+// by operationName to the extensions of the errors of the result. Errors of
+// anonymous operations are left untouched.
+func addErrorOperationName(result *Result, doc *ast.Document, operationName string) {
+	operation, err := getOperation(doc, operationName)
+	if err != nil || operation.Name == nil {
+		return
+	}
+	for i, formattedErr := range result.Errors {
+		// the extensions of the error may be shared, e.g. by an error
+		// returned from several resolvers, so they are copied
+		extensions := map[string]interface{}{}
+		for key, value := range formattedErr.Extensions {
+			extensions[key] = value
+		}
+		extensions["operationName"] = operation.Name.Value
+		result.Errors[i].Extensions = extensions
+	}
+}
+
+// rootValue returns the value used as the source of the top level resolvers.
+func (p *Params) rootValue() interface{} {
+	if p.RootValue != nil {
+		return p.RootValue
+	}
+	return p.RootObject
 }
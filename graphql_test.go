@@ -2,10 +2,14 @@ package graphql_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -194,6 +198,54 @@ func TestThreadsContextFromParamsThrough(t *testing.T) {
 
 }
 
+type testRootValue struct {
+	Greeting string `json:"greeting"`
+	names    map[int]string
+}
+
+func TestThreadsRootValueFromParamsThrough(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+				},
+				"name": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"id": &graphql.ArgumentConfig{Type: graphql.Int},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						root := p.Source.(*testRootValue)
+						return root.names[p.Args["id"].(int)], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("wrong result, unexpected errors: %v", err.Error())
+	}
+	query := `{ greeting name(id: 1) }`
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		RootValue: &testRootValue{
+			Greeting: "Hello",
+			names:    map[int]string{1: "Luke"},
+		},
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]interface{}{"greeting": "Hello", "name": "Luke"}
+	if !reflect.DeepEqual(result.Data, expected) {
+		t.Fatalf("wrong result, query: %v, graphql result diff: %v", query, testutil.Diff(expected, result))
+	}
+}
+
 func TestNewErrorChecksNilNodes(t *testing.T) {
 	schema, err := graphql.NewSchema(graphql.SchemaConfig{
 		Query: graphql.NewObject(graphql.ObjectConfig{
@@ -268,3 +320,195 @@ func TestEmptyStringIsNotNull(t *testing.T) {
 		t.Errorf("wrong result, query: %v, graphql result diff: %v", query, testutil.Diff(expected, result))
 	}
 }
+
+func TestDryRunReturnsTheResponseShapeWithoutResolving(t *testing.T) {
+	resolved := 0
+	resolve := func(p graphql.ResolveParams) (interface{}, error) {
+		resolved++
+		return nil, nil
+	}
+	var userType *graphql.Object
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
+			return graphql.Fields{
+				"id":      &graphql.Field{Type: graphql.ID, Resolve: resolve},
+				"name":    &graphql.Field{Type: graphql.String, Resolve: resolve},
+				"email":   &graphql.Field{Type: graphql.String, Resolve: resolve},
+				"friends": &graphql.Field{Type: graphql.NewList(userType), Resolve: resolve},
+			}
+		}),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"version": &graphql.Field{Type: graphql.String, Resolve: resolve},
+				"user":    &graphql.Field{Type: userType, Resolve: resolve},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("wrong result, unexpected errors: %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `
+          query User($full: Boolean!) {
+            v: version
+            user {
+              name
+              email @include(if: $full)
+              ...Friends
+              friends {
+                name
+              }
+            }
+          }
+          fragment Friends on User {
+            friends {
+              id
+            }
+          }
+        `,
+		VariableValues: map[string]interface{}{"full": false},
+		DryRun:         true,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"v": nil,
+			"user": map[string]interface{}{
+				"name": nil,
+				"friends": map[string]interface{}{
+					"id":   nil,
+					"name": nil,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if resolved != 0 {
+		t.Fatalf("expected no resolver to be called, %v were", resolved)
+	}
+
+	// requests are still validated
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ unknown }`,
+		DryRun:        true,
+	})
+	if len(result.Errors) != 1 || result.Data != nil {
+		t.Fatalf("expected a validation error, got %v", result)
+	}
+
+	// as are the limits on the operation
+	tests := []struct {
+		params   graphql.Params
+		expected string
+	}{
+		{
+			params: graphql.Params{
+				RequestString: `{ version user { name } }`,
+				MaxRootFields: 1,
+			},
+			expected: "Operation selects 2 root fields, which exceeds the maximum of 1.",
+		},
+		{
+			params: graphql.Params{
+				RequestString: `
+				  { user { ...A } }
+				  fragment A on User { ...B }
+				  fragment B on User { name }
+				`,
+				MaxFragmentDepth: 1,
+			},
+			expected: "Fragments are nested deeper than the maximum depth of 1.",
+		},
+	}
+	for _, test := range tests {
+		test.params.Schema = schema
+		test.params.DryRun = true
+		result = graphql.Do(test.params)
+		if len(result.Errors) != 1 || result.Errors[0].Message != test.expected || result.Data != nil {
+			t.Fatalf("expected the error %q, got %v", test.expected, result)
+		}
+	}
+	if resolved != 0 {
+		t.Fatalf("expected no resolver to be called, %v were", resolved)
+	}
+}
+
+func TestErrorOperationNameAddsTheOperationNameToErrorExtensions(t *testing.T) {
+	extensions := map[string]interface{}{"code": "FORBIDDEN"}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"secret": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nil, gqlerrors.NewFormattedError("forbidden")
+					},
+				},
+				"extended": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nil, extendedError{error: errors.New("forbidden"), extensions: extensions}
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:             schema,
+		RequestString:      `query Public { ok: __typename } query Secret { secret extended }`,
+		OperationName:      "Secret",
+		ErrorOperationName: true,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"secret":   nil,
+			"extended": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:    "forbidden",
+				Locations:  []location.SourceLocation{{Line: 1, Column: 48}},
+				Path:       []interface{}{"secret"},
+				Extensions: map[string]interface{}{"operationName": "Secret"},
+			},
+			{
+				Message:    "forbidden",
+				Locations:  []location.SourceLocation{{Line: 1, Column: 55}},
+				Path:       []interface{}{"extended"},
+				Extensions: map[string]interface{}{"code": "FORBIDDEN", "operationName": "Secret"},
+			},
+		},
+	}
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Locations[0].Column < result.Errors[j].Locations[0].Column
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if _, ok := extensions["operationName"]; ok {
+		t.Fatalf("expected the extensions of the resolver error to be left untouched, got %v", extensions)
+	}
+
+	// anonymous operations have no name to report
+	result = graphql.Do(graphql.Params{
+		Schema:             schema,
+		RequestString:      `{ secret }`,
+		ErrorOperationName: true,
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions != nil {
+		t.Fatalf("expected a single error without extensions, got %v", result.Errors)
+	}
+}
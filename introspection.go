@@ -232,6 +232,15 @@ func init() {
 			"enumValues":    &Field{},
 			"inputFields":   &Field{},
 			"ofType":        &Field{},
+			"isOneOf": &Field{
+				Type: Boolean,
+				Resolve: func(p ResolveParams) (interface{}, error) {
+					if ttype, ok := p.Source.(*InputObject); ok {
+						return ttype.IsOneOf(), nil
+					}
+					return nil, nil
+				},
+			},
 		},
 	})
 
@@ -262,19 +271,34 @@ func init() {
 						if isNullish(inputVal.DefaultValue) {
 							return nil, nil
 						}
-						astVal := astFromValue(inputVal.DefaultValue, inputVal)
+						astVal := astFromValue(inputVal.DefaultValue, inputVal.Type)
 						return printer.Print(astVal), nil
 					}
 					if inputVal, ok := p.Source.(*InputObjectField); ok {
 						if inputVal.DefaultValue == nil {
 							return nil, nil
 						}
-						astVal := astFromValue(inputVal.DefaultValue, inputVal)
+						astVal := astFromValue(inputVal.DefaultValue, inputVal.Type)
 						return printer.Print(astVal), nil
 					}
 					return nil, nil
 				},
 			},
+			"isDeprecated": &Field{
+				Type: NewNonNull(Boolean),
+				Resolve: func(p ResolveParams) (interface{}, error) {
+					return inputValueDeprecationReason(p.Source) != "", nil
+				},
+			},
+			"deprecationReason": &Field{
+				Type: String,
+				Resolve: func(p ResolveParams) (interface{}, error) {
+					if reason := inputValueDeprecationReason(p.Source); reason != "" {
+						return reason, nil
+					}
+					return nil, nil
+				},
+			},
 		},
 	})
 
@@ -291,9 +315,16 @@ func init() {
 			},
 			"args": &Field{
 				Type: NewNonNull(NewList(NewNonNull(InputValueType))),
+				Args: FieldConfigArgument{
+					"includeDeprecated": &ArgumentConfig{
+						Type:         Boolean,
+						DefaultValue: false,
+					},
+				},
 				Resolve: func(p ResolveParams) (interface{}, error) {
+					includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
 					if field, ok := p.Source.(*FieldDefinition); ok {
-						return field.Args, nil
+						return filterDeprecatedArgs(field.Args, includeDeprecated), nil
 					}
 					return []interface{}{}, nil
 				},
@@ -348,6 +379,19 @@ func init() {
 				Type: NewNonNull(NewList(
 					NewNonNull(InputValueType),
 				)),
+				Args: FieldConfigArgument{
+					"includeDeprecated": &ArgumentConfig{
+						Type:         Boolean,
+						DefaultValue: false,
+					},
+				},
+				Resolve: func(p ResolveParams) (interface{}, error) {
+					includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
+					if dir, ok := p.Source.(*Directive); ok {
+						return filterDeprecatedArgs(dir.Args, includeDeprecated), nil
+					}
+					return []interface{}{}, nil
+				},
 			},
 			// NOTE: the following three fields are deprecated and are no longer part
 			// of the GraphQL specification.
@@ -612,10 +656,20 @@ func init() {
 	})
 	TypeType.AddFieldConfig("inputFields", &Field{
 		Type: NewList(NewNonNull(InputValueType)),
+		Args: FieldConfigArgument{
+			"includeDeprecated": &ArgumentConfig{
+				Type:         Boolean,
+				DefaultValue: false,
+			},
+		},
 		Resolve: func(p ResolveParams) (interface{}, error) {
+			includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
 			if ttype, ok := p.Source.(*InputObject); ok {
 				fields := []*InputObjectField{}
 				for _, field := range ttype.Fields() {
+					if !includeDeprecated && field.DeprecationReason != "" {
+						continue
+					}
 					fields = append(fields, field)
 				}
 				return fields, nil
@@ -625,7 +679,39 @@ func init() {
 	})
 	TypeType.AddFieldConfig("ofType", &Field{
 		Type: TypeType,
+		Resolve: func(p ResolveParams) (interface{}, error) {
+			source, depth := p.Source, 1
+			if ref, ok := source.(ofTypeRef); ok {
+				source, depth = ref.Type, ref.depth+1
+			}
+			if maxDepth := p.Info.Schema.maxOfTypeDepth; maxDepth > 0 && depth > maxDepth {
+				return nil, nil
+			}
+			switch ttype := source.(type) {
+			case *List:
+				return ofTypeRef{Type: ttype.OfType, depth: depth}, nil
+			case *NonNull:
+				return ofTypeRef{Type: ttype.OfType, depth: depth}, nil
+			}
+			return nil, nil
+		},
 	})
+	// the other __Type fields resolve the type an ofType field refers to
+	for name, field := range TypeType.Fields() {
+		if name == "ofType" {
+			continue
+		}
+		resolve := field.Resolve
+		if resolve == nil {
+			resolve = DefaultResolveFn
+		}
+		field.Resolve = func(p ResolveParams) (interface{}, error) {
+			if ref, ok := p.Source.(ofTypeRef); ok {
+				p.Source = ref.Type
+			}
+			return resolve(p)
+		}
+	}
 
 	SchemaType.ensureCache()
 	DirectiveType.ensureCache()
@@ -676,6 +762,34 @@ func init() {
 
 }
 
+// inputValueDeprecationReason returns the deprecation reason of an argument or
+// input object field.
+func inputValueDeprecationReason(inputVal interface{}) string {
+	switch inputVal := inputVal.(type) {
+	case *Argument:
+		return inputVal.DeprecationReason
+	case *InputObjectField:
+		return inputVal.DeprecationReason
+	}
+	return ""
+}
+
+// filterDeprecatedArgs returns the given arguments, leaving out the deprecated
+// ones unless includeDeprecated is set.
+func filterDeprecatedArgs(args []*Argument, includeDeprecated bool) []*Argument {
+	if includeDeprecated {
+		return args
+	}
+	filtered := []*Argument{}
+	for _, arg := range args {
+		if arg.DeprecationReason != "" {
+			continue
+		}
+		filtered = append(filtered, arg)
+	}
+	return filtered
+}
+
 // Produces a GraphQL Value AST given a Golang value.
 //
 // Optionally, a GraphQL type may be provided, which will be used to
@@ -735,8 +849,45 @@ func astFromValue(value interface{}, ttype Type) ast.Value {
 		return val
 	}
 
-	if valueVal.Type().Kind() == reflect.Map {
-		// TODO: implement astFromValue from Map to Value
+	// Convert Golang map to GraphQL input object, with its fields in a stable
+	// order. Fields the map does not have a value for are omitted.
+	if ttype, ok := ttype.(*InputObject); ok {
+		if valueVal.Type().Kind() != reflect.Map || valueVal.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		fieldMap := ttype.Fields()
+		fieldNames := make([]string, 0, len(fieldMap))
+		for name := range fieldMap {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+		fields := []*ast.ObjectField{}
+		for _, name := range fieldNames {
+			fieldVal := valueVal.MapIndex(reflect.ValueOf(name).Convert(valueVal.Type().Key()))
+			if !fieldVal.IsValid() {
+				continue
+			}
+			fieldAST := astFromValue(fieldVal.Interface(), fieldMap[name].Type)
+			if fieldAST == nil {
+				continue
+			}
+			fields = append(fields, ast.NewObjectField(&ast.ObjectField{
+				Name:  ast.NewName(&ast.Name{Value: name}),
+				Value: fieldAST,
+			}))
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{
+			Fields: fields,
+		})
+	}
+
+	// Enum values are printed by name, which may differ from their value.
+	if ttype, ok := ttype.(*Enum); ok && valueVal.Type().Comparable() {
+		if name, ok := ttype.Serialize(value).(string); ok {
+			return ast.NewEnumValue(&ast.EnumValue{
+				Value: name,
+			})
+		}
 	}
 
 	if value, ok := value.(bool); ok {
@@ -781,3 +932,56 @@ func astFromValue(value interface{}, ttype Type) ast.Value {
 		Value: fmt.Sprintf("%v", value),
 	})
 }
+
+// FilterIntrospection post-processes the result of an introspection query,
+// such as the Data of executing testutil.IntrospectionQuery, and strips the
+// deprecated fields, enum values, arguments and input fields from it unless
+// includeDeprecated is set. The introspection query must select isDeprecated
+// on those elements for them to be recognised. The given result is left
+// unchanged; a filtered copy is returned, even if includeDeprecated is set.
+func FilterIntrospection(result interface{}, includeDeprecated bool) interface{} {
+	switch result := result.(type) {
+	case map[string]interface{}:
+		filtered := make(map[string]interface{}, len(result))
+		for key, value := range result {
+			switch key {
+			case "fields", "enumValues", "args", "inputFields":
+				if elements, ok := value.([]interface{}); ok && !includeDeprecated {
+					value = filterDeprecatedElements(elements)
+				}
+			}
+			filtered[key] = FilterIntrospection(value, includeDeprecated)
+		}
+		return filtered
+	case []interface{}:
+		filtered := make([]interface{}, len(result))
+		for i, value := range result {
+			filtered[i] = FilterIntrospection(value, includeDeprecated)
+		}
+		return filtered
+	}
+	return result
+}
+
+// filterDeprecatedElements leaves out the introspected elements that are
+// marked as deprecated.
+func filterDeprecatedElements(elements []interface{}) []interface{} {
+	filtered := []interface{}{}
+	for _, element := range elements {
+		if element, ok := element.(map[string]interface{}); ok {
+			if isDeprecated, _ := element["isDeprecated"].(bool); isDeprecated {
+				continue
+			}
+		}
+		filtered = append(filtered, element)
+	}
+	return filtered
+}
+
+// ofTypeRef is the type an ofType field refers to, along with how many
+// ofType fields led to it, so the depth is known whatever the fields are
+// aliased to.
+type ofTypeRef struct {
+	Type  Type
+	depth int
+}
@@ -1,6 +1,7 @@
 package graphql_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -1111,6 +1112,319 @@ func TestIntrospection_RespectsTheIncludeDeprecatedParameterForFields(t *testing
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+func TestIntrospection_RespectsTheIncludeDeprecatedParameterForArgs(t *testing.T) {
+
+	testType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TestType",
+		Fields: graphql.Fields{
+			"deprecatedArgField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"deprecated": &graphql.ArgumentConfig{
+						Type:              graphql.String,
+						DeprecationReason: "Removed in 1.0",
+					},
+				},
+			},
+			"nonDeprecatedArgField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"nonDeprecated": &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+			},
+		},
+	})
+	testDirective := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "testDirective",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			"deprecated": &graphql.ArgumentConfig{
+				Type:              graphql.String,
+				DeprecationReason: "Removed in 1.0",
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query:      testType,
+		Directives: []*graphql.Directive{testDirective},
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        __type(name: "TestType") {
+          fields {
+            name
+            trueArgs: args(includeDeprecated: true) {
+              name
+            }
+            falseArgs: args(includeDeprecated: false) {
+              name
+            }
+            omittedArgs: args {
+              name
+            }
+          }
+        }
+        __schema {
+          directives {
+            trueArgs: args(includeDeprecated: true) {
+              name
+            }
+            falseArgs: args(includeDeprecated: false) {
+              name
+            }
+          }
+        }
+      }
+    `
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"__type": map[string]interface{}{
+				"fields": []interface{}{
+					map[string]interface{}{
+						"name": "deprecatedArgField",
+						"trueArgs": []interface{}{
+							map[string]interface{}{
+								"name": "deprecated",
+							},
+						},
+						"falseArgs":   []interface{}{},
+						"omittedArgs": []interface{}{},
+					},
+					map[string]interface{}{
+						"name": "nonDeprecatedArgField",
+						"trueArgs": []interface{}{
+							map[string]interface{}{
+								"name": "nonDeprecated",
+							},
+						},
+						"falseArgs": []interface{}{
+							map[string]interface{}{
+								"name": "nonDeprecated",
+							},
+						},
+						"omittedArgs": []interface{}{
+							map[string]interface{}{
+								"name": "nonDeprecated",
+							},
+						},
+					},
+				},
+			},
+			"__schema": map[string]interface{}{
+				"directives": []interface{}{
+					map[string]interface{}{
+						"trueArgs": []interface{}{
+							map[string]interface{}{
+								"name": "deprecated",
+							},
+						},
+						"falseArgs": []interface{}{},
+					},
+				},
+			},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIntrospection_IdentifiesDeprecatedArgsAndInputFields(t *testing.T) {
+
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"oldLimit": &graphql.InputObjectFieldConfig{
+				Type:              graphql.Int,
+				DeprecationReason: "Use `limit`.",
+			},
+		},
+	})
+	testType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TestType",
+		Fields: graphql.Fields{
+			"search": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{
+						Type:              filterType,
+						DeprecationReason: "Removed in 1.0",
+					},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: testType,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        __type(name: "TestType") {
+          fields {
+            args(includeDeprecated: true) {
+              name
+              isDeprecated
+              deprecationReason
+              type {
+                inputFields(includeDeprecated: true) {
+                  name
+                  isDeprecated
+                  deprecationReason
+                }
+              }
+            }
+          }
+        }
+      }
+    `
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"__type": map[string]interface{}{
+				"fields": []interface{}{
+					map[string]interface{}{
+						"args": []interface{}{
+							map[string]interface{}{
+								"name":              "filter",
+								"isDeprecated":      true,
+								"deprecationReason": "Removed in 1.0",
+								"type": map[string]interface{}{
+									"inputFields": []interface{}{
+										map[string]interface{}{
+											"name":              "oldLimit",
+											"isDeprecated":      true,
+											"deprecationReason": "Use `limit`.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIntrospection_RespectsTheIncludeDeprecatedParameterForInputFields(t *testing.T) {
+
+	deprecatedInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "DeprecatedInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"deprecated": &graphql.InputObjectFieldConfig{
+				Type:              graphql.String,
+				DeprecationReason: "Removed in 1.0",
+			},
+		},
+	})
+	nonDeprecatedInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "NonDeprecatedInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nonDeprecated": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	testType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TestType",
+		Fields: graphql.Fields{
+			"testField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"a": &graphql.ArgumentConfig{
+						Type: deprecatedInputType,
+					},
+					"b": &graphql.ArgumentConfig{
+						Type: nonDeprecatedInputType,
+					},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: testType,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        deprecatedInput: __type(name: "DeprecatedInput") {
+          ...InputFields
+        }
+        nonDeprecatedInput: __type(name: "NonDeprecatedInput") {
+          ...InputFields
+        }
+      }
+      fragment InputFields on __Type {
+        trueFields: inputFields(includeDeprecated: true) {
+          name
+        }
+        falseFields: inputFields(includeDeprecated: false) {
+          name
+        }
+        omittedFields: inputFields {
+          name
+        }
+      }
+    `
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"deprecatedInput": map[string]interface{}{
+				"trueFields": []interface{}{
+					map[string]interface{}{
+						"name": "deprecated",
+					},
+				},
+				"falseFields":   []interface{}{},
+				"omittedFields": []interface{}{},
+			},
+			"nonDeprecatedInput": map[string]interface{}{
+				"trueFields": []interface{}{
+					map[string]interface{}{
+						"name": "nonDeprecated",
+					},
+				},
+				"falseFields": []interface{}{
+					map[string]interface{}{
+						"name": "nonDeprecated",
+					},
+				},
+				"omittedFields": []interface{}{
+					map[string]interface{}{
+						"name": "nonDeprecated",
+					},
+				},
+			},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func TestIntrospection_IdentifiesDeprecatedEnumValues(t *testing.T) {
 
 	testEnum := graphql.NewEnum(graphql.EnumConfig{
@@ -1186,6 +1500,54 @@ func TestIntrospection_IdentifiesDeprecatedEnumValues(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+func TestIntrospection_ListsEnumValuesInAStableOrder(t *testing.T) {
+	values := graphql.EnumValueConfigMap{}
+	for i, name := range []string{"MERCURY", "VENUS", "EARTH", "MARS", "JUPITER", "SATURN", "URANUS", "NEPTUNE"} {
+		values[name] = &graphql.EnumValueConfig{Value: i}
+	}
+	expected := []interface{}{
+		map[string]interface{}{"name": "EARTH"},
+		map[string]interface{}{"name": "JUPITER"},
+		map[string]interface{}{"name": "MARS"},
+		map[string]interface{}{"name": "MERCURY"},
+		map[string]interface{}{"name": "NEPTUNE"},
+		map[string]interface{}{"name": "SATURN"},
+		map[string]interface{}{"name": "URANUS"},
+		map[string]interface{}{"name": "VENUS"},
+	}
+	// the values are configured in a map, so a new enum is built each time
+	// to check they are not listed in the map's random order.
+	for i := 0; i < 10; i++ {
+		planetType := graphql.NewEnum(graphql.EnumConfig{
+			Name:   "Planet",
+			Values: values,
+		})
+		schema, err := graphql.NewSchema(graphql.SchemaConfig{
+			Query: graphql.NewObject(graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"planet": &graphql.Field{
+						Type: planetType,
+					},
+				},
+			}),
+		})
+		if err != nil {
+			t.Fatalf("Error creating Schema: %v", err.Error())
+		}
+		result := g(t, graphql.Params{
+			Schema:        schema,
+			RequestString: `{ __type(name: "Planet") { enumValues { name } } }`,
+		})
+		if len(result.Errors) != 0 {
+			t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+		}
+		enumValues := result.Data.(map[string]interface{})["__type"].(map[string]interface{})["enumValues"]
+		if !reflect.DeepEqual(expected, enumValues) {
+			t.Fatalf("Unexpected enum values, Diff: %v", testutil.Diff(expected, enumValues))
+		}
+	}
+}
 func TestIntrospection_RespectsTheIncludeDeprecatedParameterForEnumValues(t *testing.T) {
 
 	testEnum := graphql.NewEnum(graphql.EnumConfig{
@@ -1468,3 +1830,501 @@ func TestIntrospection_ExposesDescriptionsOnEnums(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestIntrospection_ReportsNonNullArgumentsWithADefaultAsNotRequired(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greet": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"name": &graphql.ArgumentConfig{
+							Type:         graphql.NewNonNull(graphql.String),
+							DefaultValue: "World",
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "Hello, " + p.Args["name"].(string), nil
+					},
+				},
+			},
+		}),
+		StrictArguments: true,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	// the strict mode only warns about the argument, which stays optional
+	if len(schema.Warnings()) != 1 {
+		t.Fatalf("expected a single warning, got: %v", schema.Warnings())
+	}
+	query := `
+      {
+        greet
+        __type(name: "Query") {
+          fields {
+            args {
+              name
+              type {
+                kind
+              }
+              defaultValue
+            }
+          }
+        }
+      }
+    `
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"greet": "Hello, World",
+			"__type": map[string]interface{}{
+				"fields": []interface{}{
+					map[string]interface{}{
+						"args": []interface{}{
+							map[string]interface{}{
+								"name": "name",
+								"type": map[string]interface{}{
+									"kind": "NON_NULL",
+								},
+								"defaultValue": `"World"`,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIntrospection_TruncatesOfTypeAtMaxOfTypeDepth(t *testing.T) {
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"grid": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewList(graphql.NewNonNull(graphql.String)))),
+			},
+		},
+	})
+	request := `
+      {
+        __type(name: "Query") {
+          fields {
+            type {
+              kind
+              ofType {
+                kind
+                ofType {
+                  kind
+                  ofType {
+                    kind
+                    ofType {
+                      kind
+                      name
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    `
+	fieldType := func(result *graphql.Result) interface{} {
+		return result.Data.(map[string]interface{})["__type"].(map[string]interface{})["fields"].([]interface{})[0].(map[string]interface{})["type"]
+	}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: query,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: request,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]interface{}{
+		"kind": "NON_NULL",
+		"ofType": map[string]interface{}{
+			"kind": "LIST",
+			"ofType": map[string]interface{}{
+				"kind": "LIST",
+				"ofType": map[string]interface{}{
+					"kind": "NON_NULL",
+					"ofType": map[string]interface{}{
+						"kind": "SCALAR",
+						"name": "String",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, fieldType(result)) {
+		t.Fatalf("Unexpected type, Diff: %v", testutil.Diff(expected, fieldType(result)))
+	}
+
+	schema, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query:          query,
+		MaxOfTypeDepth: 2,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	result = g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: request,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected = map[string]interface{}{
+		"kind": "NON_NULL",
+		"ofType": map[string]interface{}{
+			"kind": "LIST",
+			"ofType": map[string]interface{}{
+				"kind":   "LIST",
+				"ofType": nil,
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, fieldType(result)) {
+		t.Fatalf("Unexpected type, Diff: %v", testutil.Diff(expected, fieldType(result)))
+	}
+
+	// aliases and fragments do not hide ofType fields from the limit
+	result = g(t, graphql.Params{
+		Schema: schema,
+		RequestString: `
+          {
+            __type(name: "Query") {
+              fields {
+                type {
+                  kind
+                  a: ofType {
+                    kind
+                    ... on __Type {
+                      b: ofType {
+                        ...OfType
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          }
+          fragment OfType on __Type {
+            kind
+            c: ofType {
+              kind
+            }
+          }
+        `,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected = map[string]interface{}{
+		"kind": "NON_NULL",
+		"a": map[string]interface{}{
+			"kind": "LIST",
+			"b": map[string]interface{}{
+				"kind": "LIST",
+				"c":    nil,
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, fieldType(result)) {
+		t.Fatalf("Unexpected type, Diff: %v", testutil.Diff(expected, fieldType(result)))
+	}
+}
+
+func TestIntrospection_PrintsListAndEnumDefaultValuesAsLiterals(t *testing.T) {
+	directionType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Direction",
+		Values: graphql.EnumValueConfigMap{
+			"NORTH": &graphql.EnumValueConfig{Value: 0},
+			"SOUTH": &graphql.EnumValueConfig{Value: 1},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"route": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"dir": &graphql.ArgumentConfig{
+							Type:         directionType,
+							DefaultValue: 1,
+						},
+						"dirs": &graphql.ArgumentConfig{
+							Type:         graphql.NewList(directionType),
+							DefaultValue: []interface{}{1, 0},
+						},
+						"names": &graphql.ArgumentConfig{
+							Type:         graphql.NewList(graphql.String),
+							DefaultValue: []string{"foo", "bar"},
+						},
+						"name": &graphql.ArgumentConfig{
+							Type:         graphql.String,
+							DefaultValue: "foo",
+						},
+						"flag": &graphql.ArgumentConfig{
+							Type:         graphql.Boolean,
+							DefaultValue: false,
+						},
+						"matrix": &graphql.ArgumentConfig{
+							Type:         graphql.NewList(graphql.NewList(graphql.Int)),
+							DefaultValue: [][]int{{1, 2}, {3}},
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	result := g(t, graphql.Params{
+		Schema: schema,
+		RequestString: `
+          {
+            __type(name: "Query") {
+              fields {
+                args {
+                  name
+                  defaultValue
+                }
+              }
+            }
+          }
+        `,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]interface{}{
+		"dir":    "SOUTH",
+		"dirs":   "[SOUTH, NORTH]",
+		"names":  `["foo", "bar"]`,
+		"name":   `"foo"`,
+		"flag":   "false",
+		"matrix": "[[1, 2], [3]]",
+	}
+	defaults := map[string]interface{}{}
+	args := result.Data.(map[string]interface{})["__type"].(map[string]interface{})["fields"].([]interface{})[0].(map[string]interface{})["args"]
+	for _, arg := range args.([]interface{}) {
+		arg := arg.(map[string]interface{})
+		defaults[arg["name"].(string)] = arg["defaultValue"]
+	}
+	if !reflect.DeepEqual(expected, defaults) {
+		t.Fatalf("Unexpected default values, Diff: %v", testutil.Diff(expected, defaults))
+	}
+}
+
+func TestIntrospection_PrintsEnumAndInputObjectDefaultValues(t *testing.T) {
+	directionType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Direction",
+		Values: graphql.EnumValueConfigMap{
+			"NORTH": &graphql.EnumValueConfig{Value: 0},
+			"SOUTH": &graphql.EnumValueConfig{Value: 1},
+		},
+	})
+	pointType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Point",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"x": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"y": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+	moveType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Move",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"dir": &graphql.InputObjectFieldConfig{
+				Type:         directionType,
+				DefaultValue: 1,
+			},
+			"from": &graphql.InputObjectFieldConfig{
+				Type: pointType,
+				DefaultValue: map[string]interface{}{
+					"x": 1,
+					"y": 2,
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"move": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"move": &graphql.ArgumentConfig{
+							Type: moveType,
+							DefaultValue: map[string]interface{}{
+								"dir":  0,
+								"from": map[string]interface{}{"x": 3},
+							},
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        query: __type(name: "Query") {
+          fields {
+            args {
+              defaultValue
+            }
+          }
+        }
+        move: __type(name: "Move") {
+          inputFields {
+            name
+            defaultValue
+          }
+        }
+      }
+    `
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expectedArgs := []interface{}{
+		map[string]interface{}{
+			"defaultValue": "{dir: NORTH, from: {x: 3}}",
+		},
+	}
+	args := result.Data.(map[string]interface{})["query"].(map[string]interface{})["fields"].([]interface{})[0].(map[string]interface{})["args"]
+	if !reflect.DeepEqual(expectedArgs, args) {
+		t.Fatalf("Unexpected args, Diff: %v", testutil.Diff(expectedArgs, args))
+	}
+	expectedDefaults := map[string]interface{}{
+		"dir":  "SOUTH",
+		"from": "{x: 1, y: 2}",
+	}
+	defaults := map[string]interface{}{}
+	for _, field := range result.Data.(map[string]interface{})["move"].(map[string]interface{})["inputFields"].([]interface{}) {
+		field := field.(map[string]interface{})
+		defaults[field["name"].(string)] = field["defaultValue"]
+	}
+	if !reflect.DeepEqual(expectedDefaults, defaults) {
+		t.Fatalf("Unexpected default values, Diff: %v", testutil.Diff(expectedDefaults, defaults))
+	}
+}
+
+func TestIntrospection_FilterIntrospectionStripsDeprecatedElements(t *testing.T) {
+
+	testType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TestType",
+		Fields: graphql.Fields{
+			"nonDeprecated": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"old": &graphql.ArgumentConfig{
+						Type:              graphql.String,
+						DeprecationReason: "Removed in 1.0",
+					},
+				},
+			},
+			"deprecated": &graphql.Field{
+				Type:              graphql.String,
+				DeprecationReason: "Removed in 1.0",
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: testType,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        __type(name: "TestType") {
+          name
+          fields(includeDeprecated: true) {
+            name
+            isDeprecated
+            args(includeDeprecated: true) {
+              name
+              isDeprecated
+            }
+          }
+        }
+      }
+    `
+	result := g(t, graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	unfiltered := map[string]interface{}{
+		"__type": map[string]interface{}{
+			"name": "TestType",
+			"fields": []interface{}{
+				map[string]interface{}{
+					"name":         "deprecated",
+					"isDeprecated": true,
+					"args":         []interface{}{},
+				},
+				map[string]interface{}{
+					"name":         "nonDeprecated",
+					"isDeprecated": false,
+					"args": []interface{}{
+						map[string]interface{}{
+							"name":         "old",
+							"isDeprecated": true,
+						},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(unfiltered, result.Data) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(unfiltered, result.Data))
+	}
+	filtered := map[string]interface{}{
+		"__type": map[string]interface{}{
+			"name": "TestType",
+			"fields": []interface{}{
+				map[string]interface{}{
+					"name":         "nonDeprecated",
+					"isDeprecated": false,
+					"args":         []interface{}{},
+				},
+			},
+		},
+	}
+	if actual := graphql.FilterIntrospection(result.Data, false); !reflect.DeepEqual(filtered, actual) {
+		t.Fatalf("Unexpected filtered result, Diff: %v", testutil.Diff(filtered, actual))
+	}
+	copied := graphql.FilterIntrospection(result.Data, true)
+	if !reflect.DeepEqual(unfiltered, copied) {
+		t.Fatalf("Unexpected result with includeDeprecated, Diff: %v", testutil.Diff(unfiltered, copied))
+	}
+	// the copy may be changed without changing the result
+	copied.(map[string]interface{})["__type"].(map[string]interface{})["name"] = "Changed"
+	if !reflect.DeepEqual(unfiltered, result.Data) {
+		t.Fatalf("Expected the introspection result to be left unchanged, Diff: %v", testutil.Diff(unfiltered, result.Data))
+	}
+}
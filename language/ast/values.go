@@ -117,6 +117,10 @@ type StringValue struct {
 	Kind  string
 	Loc   *Location
 	Value string
+
+	// Block is true if the string was written as a block string, delimited
+	// by triple quotes.
+	Block bool
 }
 
 func NewStringValue(v *StringValue) *StringValue {
@@ -127,6 +131,7 @@ func NewStringValue(v *StringValue) *StringValue {
 		Kind:  kinds.StringValue,
 		Loc:   v.Loc,
 		Value: v.Value,
+		Block: v.Block,
 	}
 }
 
@@ -215,10 +215,12 @@ func readDigits(s *source.Source, start int, firstCode rune, codeLength int) (in
 	return position, gqlerrors.NewSyntaxError(s, position, description)
 }
 
-func readString(s *source.Source, start int) (Token, error) {
+// readString reads a string token from the source file, starting at the byte
+// position start and the rune position runeStart of its opening quote.
+func readString(s *source.Source, start, runeStart int) (Token, error) {
 	body := s.Body
 	position := start + 1
-	runePosition := start + 1
+	runePosition := runeStart + 1
 	chunkStart := position
 	var code rune
 	var n int
@@ -301,7 +303,9 @@ func readString(s *source.Source, start int) (Token, error) {
 		}
 	}
 	if code != '"' { // quote (")
-		return Token{}, gqlerrors.NewSyntaxError(s, runePosition, "Unterminated string.")
+		// reported where the string starts, which is easier to spot than where
+		// the line or the document ends
+		return Token{}, gqlerrors.NewSyntaxError(s, runeStart, "Unterminated string.")
 	}
 	stringContent := body[chunkStart:position]
 	valueBuffer.Write(stringContent)
@@ -312,10 +316,10 @@ func readString(s *source.Source, start int) (Token, error) {
 // readBlockString reads a block string token from the source file.
 //
 // """("?"?(\\"""|\\(?!=""")|[^"\\]))*"""
-func readBlockString(s *source.Source, start int) (Token, error) {
+func readBlockString(s *source.Source, start, runeStart int) (Token, error) {
 	body := s.Body
 	position := start + 3
-	runePosition := start + 3
+	runePosition := runeStart + 3
 	chunkStart := position
 	var valueBuffer bytes.Buffer
 
@@ -366,7 +370,7 @@ func readBlockString(s *source.Source, start int) (Token, error) {
 		runePosition++
 	}
 
-	return Token{}, gqlerrors.NewSyntaxError(s, runePosition, "Unterminated string.")
+	return Token{}, gqlerrors.NewSyntaxError(s, runeStart, "Unterminated block string.")
 }
 
 var splitLinesRegex = regexp.MustCompile("\r\n|[\n\r]")
@@ -565,9 +569,9 @@ func readToken(s *source.Source, fromPosition int) (Token, error) {
 		x, _ := runeAt(body, position+1)
 		y, _ := runeAt(body, position+2)
 		if x == '"' && y == '"' {
-			token, err = readBlockString(s, position)
+			token, err = readBlockString(s, position, runePosition)
 		} else {
-			token, err = readString(s, position)
+			token, err = readString(s, position, runePosition)
 		}
 		return token, err
 	}
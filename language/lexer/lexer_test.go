@@ -312,18 +312,18 @@ func TestLexer_ReportsUsefulStringErrors(t *testing.T) {
 	tests := []Test{
 		{
 			Body: "\"",
-			Expected: `Syntax Error GraphQL (1:2) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated string.
 
 1: "
-    ^
+   ^
 `,
 		},
 		{
 			Body: "\"no end quote",
-			Expected: `Syntax Error GraphQL (1:14) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated string.
 
 1: "no end quote
-                ^
+   ^
 `,
 		},
 		{
@@ -344,19 +344,19 @@ func TestLexer_ReportsUsefulStringErrors(t *testing.T) {
 		},
 		{
 			Body: "\"multi\nline\"",
-			Expected: `Syntax Error GraphQL (1:7) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated string.
 
 1: "multi
-         ^
+   ^
 2: line"
 `,
 		},
 		{
 			Body: "\"multi\rline\"",
-			Expected: `Syntax Error GraphQL (1:7) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated string.
 
 1: "multi
-         ^
+   ^
 2: line"
 `,
 		},
@@ -575,18 +575,18 @@ func TestLexer_ReportsUsefulBlockStringErrors(t *testing.T) {
 	tests := []Test{
 		{
 			Body: `"""`,
-			Expected: `Syntax Error GraphQL (1:4) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated block string.
 
 1: """
-      ^
+   ^
 `,
 		},
 		{
 			Body: `"""no end quote`,
-			Expected: `Syntax Error GraphQL (1:16) Unterminated string.
+			Expected: `Syntax Error GraphQL (1:1) Unterminated block string.
 
 1: """no end quote
-                  ^
+   ^
 `,
 		},
 		{
@@ -79,8 +79,9 @@ func Parse(p ParseParams) (*ast.Document, error) {
 	return doc, nil
 }
 
-// TODO: test and expose parseValue as a public
-func parseValue(p ParseParams) (ast.Value, error) {
+// ParseValue parses a GraphQL value literal on its own, such as the default
+// value of an argument, rather than a full document.
+func ParseValue(p ParseParams) (ast.Value, error) {
 	var value ast.Value
 	var sourceObj *source.Source
 	switch src := p.Source.(type) {
@@ -98,6 +99,9 @@ func parseValue(p ParseParams) (ast.Value, error) {
 	if err != nil {
 		return value, err
 	}
+	if _, err = expect(parser, lexer.EOF); err != nil {
+		return nil, err
+	}
 	return value, nil
 }
 
@@ -1458,6 +1462,7 @@ func parseStringLiteral(parser *Parser) (*ast.StringValue, error) {
 	}
 	return ast.NewStringValue(&ast.StringValue{
 		Value: token.Value,
+		Block: token.Kind == lexer.BLOCK_STRING,
 		Loc:   loc(parser, token.Start),
 	}), nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
 	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/language/printer"
 	"github.com/graphql-go/graphql/language/source"
@@ -147,6 +148,30 @@ func TestParseProvidesUsefulErrorsWhenUsingSource(t *testing.T) {
 	testErrorMessage(t, test)
 }
 
+func TestParseReportsUnterminatedStringsWhereTheyStart(t *testing.T) {
+	testErrorMessagesTable := []errorMessageTest{
+		{
+			"query {\n  f(a: \"oops\n}",
+			`Syntax Error GraphQL (2:8) Unterminated string.`,
+			false,
+		},
+		{
+			`{ f(a: "ünterminated) }`,
+			`Syntax Error GraphQL (1:8) Unterminated string.`,
+			false,
+		},
+		{
+			"{ f(a: \"\"\"never\nclosed) }",
+			`Syntax Error GraphQL (1:8) Unterminated block string.`,
+			false,
+		},
+	}
+	for _, test := range testErrorMessagesTable {
+		_, err := Parse(ParseParams{Source: test.source})
+		checkErrorMessage(t, err, test.expectedMessage)
+	}
+}
+
 func TestParsesVariableInlineValues(t *testing.T) {
 	source := `{ field(complex: { a: { b: [ $var ] } }) }`
 	// should not return error
@@ -165,6 +190,49 @@ func TestParsesConstantDefaultValues(t *testing.T) {
 	testErrorMessage(t, test)
 }
 
+func TestParseValue_ParsesAnObjectValue(t *testing.T) {
+	value, err := ParseValue(ParseParams{
+		Source:  `{a: [1, 2]}`,
+		Options: ParseOptions{NoLocation: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &ast.ObjectValue{
+		Kind: "ObjectValue",
+		Fields: []*ast.ObjectField{
+			{
+				Kind: "ObjectField",
+				Name: &ast.Name{
+					Kind:  "Name",
+					Value: "a",
+				},
+				Value: &ast.ListValue{
+					Kind: "ListValue",
+					Values: []ast.Value{
+						&ast.IntValue{
+							Kind:  "IntValue",
+							Value: "1",
+						},
+						&ast.IntValue{
+							Kind:  "IntValue",
+							Value: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, value) {
+		t.Fatalf("unexpected value.\nexpected:\n%#v\n\ngot:\n%#v", expected, value)
+	}
+}
+
+func TestParseValue_RejectsTrailingTokens(t *testing.T) {
+	_, err := ParseValue(ParseParams{Source: `[1, 2] 3`})
+	checkErrorMessage(t, err, `Syntax Error GraphQL (1:8) Expected EOF, found Int "3"`)
+}
+
 func TestDoesNotAcceptFragmentsNameOn(t *testing.T) {
 	test := errorMessageTest{
 		`fragment on on on { on }`,
@@ -498,6 +566,52 @@ func TestParsesEnumValueDefinitionWithDescription(t *testing.T) {
 	}
 }
 
+func TestParsesBlockStringsAsStringValues(t *testing.T) {
+	source := `
+		"""
+		Foo is quite the type.
+
+		  It has an indented \""" line.
+		"""
+		type Foo {
+			"foo is quite the field."
+			foo(bar: String = """  bar  """): String!
+		}
+	`
+	document, err := Parse(ParseParams{Source: source, Options: ParseOptions{NoLocation: true}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	definition := document.Definitions[0].(*ast.ObjectDefinition)
+	field := definition.Fields[0]
+	strs := []*ast.StringValue{
+		definition.Description,
+		field.Description,
+		field.Arguments[0].DefaultValue.(*ast.StringValue),
+	}
+	expected := []*ast.StringValue{
+		{
+			Kind:  kinds.StringValue,
+			Value: "Foo is quite the type.\n\n  It has an indented \"\"\" line.",
+			Block: true,
+		},
+		{
+			Kind:  kinds.StringValue,
+			Value: "foo is quite the field.",
+		},
+		{
+			Kind:  kinds.StringValue,
+			Value: "  bar  ",
+			Block: true,
+		},
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(expected[i], strs[i]) {
+			t.Fatalf("unexpected string value.\nexpected:\n%#v\n\ngot:\n%#v", expected[i], strs[i])
+		}
+	}
+}
+
 func TestDefinitionsWithDescriptions(t *testing.T) {
 	testCases := []struct {
 		name            string
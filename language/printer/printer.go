@@ -2,7 +2,6 @@ package printer
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"reflect"
@@ -72,24 +71,74 @@ func getMapValueString(m map[string]interface{}, key string) string {
 	return ""
 }
 func getDescription(raw interface{}) string {
-	var desc string
-
+	var (
+		desc  string
+		block bool
+	)
 	switch node := raw.(type) {
 	case ast.DescribableNode:
 		if sval := node.GetDescription(); sval != nil {
 			desc = sval.Value
+			block = sval.Block
 		}
 	case map[string]interface{}:
 		desc = getMapValueString(node, "Description.Value")
+		block, _ = getMapValue(node, "Description.Block").(bool)
+	}
+	if desc == "" {
+		return ""
+	}
+	// multi-line descriptions are easier to read as block strings, however
+	// they were written
+	if block || strings.ContainsRune(desc, '\n') {
+		return printBlockString(desc)
 	}
-	if desc != "" {
-		sep := ""
-		if strings.ContainsRune(desc, '\n') {
-			sep = "\n"
+	return printString(desc)
+}
+
+// printString prints a string as a quoted string literal, escaping the
+// characters GraphQL strings cannot contain as they are.
+func printString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
 		}
-		desc = join([]string{`"""`, desc, `"""`}, sep)
 	}
-	return desc
+	b.WriteByte('"')
+	return b.String()
+}
+
+// printBlockString prints a string as a block string, escaping the triple
+// quotes it contains. The value is put on lines of its own when it spans
+// several lines, or when it ends with a character that would run into the
+// closing quotes.
+func printBlockString(value string) string {
+	escaped := strings.Replace(value, `"""`, `\"""`, -1)
+	if strings.ContainsRune(value, '\n') || strings.HasSuffix(value, `"`) || strings.HasSuffix(value, `\`) {
+		return "\"\"\"\n" + escaped + "\n\"\"\""
+	}
+	return `"""` + escaped + `"""`
 }
 
 func toSliceString(slice interface{}) []string {
@@ -373,8 +422,14 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 	"StringValue": func(p visitor.VisitFuncParams) (string, interface{}) {
 		switch node := p.Node.(type) {
 		case *ast.StringValue:
-			return visitor.ActionUpdate, strconv.Quote(node.Value)
+			if node.Block {
+				return visitor.ActionUpdate, printBlockString(node.Value)
+			}
+			return visitor.ActionUpdate, printString(node.Value)
 		case map[string]interface{}:
+			if block, _ := getMapValue(node, "Block").(bool); block {
+				return visitor.ActionUpdate, printBlockString(getMapValueString(node, "Value"))
+			}
 			return visitor.ActionUpdate, `"` + getMapValueString(node, "Value") + `"`
 		}
 		return visitor.ActionNoChange, nil
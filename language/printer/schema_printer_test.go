@@ -305,3 +305,40 @@ directive @DirectiveMultiLine on SCALAR
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, results))
 	}
 }
+
+func TestSchemaPrinter_RoundTripsDescriptionsAndBlockStrings(t *testing.T) {
+	query := `
+"""
+A user of the service.
+
+  Indented lines keep their indentation.
+"""
+type User {
+  "The name of the user, with \"quotes\" and a \u0007 bell."
+  name: String
+
+  """Ends with a "quote" """
+  nickname(
+    """
+    How the nickname is formatted, e.g. \"""casual\""".
+    """
+    format: String = """  padded  """
+  ): String
+}
+
+"""Single line"""
+enum Role {
+  "Quoted single line"
+  ADMIN
+}
+`
+	astDoc := parse(t, query)
+	printed := printer.Print(astDoc).(string)
+	reparsed := parse(t, printed)
+	if !reflect.DeepEqual(astDoc, reparsed) {
+		t.Fatalf("Unexpected reparsed document from:\n%v\nDiff: %v", printed, testutil.Diff(astDoc, reparsed))
+	}
+	if reprinted := printer.Print(reparsed); reprinted != printed {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(printed, reprinted))
+	}
+}
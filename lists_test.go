@@ -923,3 +923,110 @@ func TestLists_NullableListOfInt_ReturnsNull(t *testing.T) {
 	}
 	checkList(t, ttype, data, expected)
 }
+
+func TestLists_IteratorIsDrainedIntoList(t *testing.T) {
+	values := []interface{}{1, 2, 3}
+	var listTestSchema, _ = graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"list": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.Int)),
+					Resolve: func(_ graphql.ResolveParams) (interface{}, error) {
+						i := 0
+						return func() (interface{}, bool) {
+							if i >= len(values) {
+								return nil, false
+							}
+							i++
+							return values[i-1], true
+						}, nil
+					},
+				},
+			},
+		}),
+	})
+	query := "{ list }"
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"list": []interface{}{1, 2, 3},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        listTestSchema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+type listTestUser struct {
+	ID      string `json:"id"`
+	Name    string
+	Friends []listTestUser `json:"friends"`
+}
+
+func TestLists_TypedSliceOfStructs(t *testing.T) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":   &graphql.Field{Type: graphql.ID},
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	userType.AddFieldConfig("friends", &graphql.Field{Type: graphql.NewList(userType)})
+	users := []listTestUser{
+		{ID: "1", Name: "Alice", Friends: []listTestUser{{ID: "2", Name: "Bob"}}},
+		{ID: "2", Name: "Bob"},
+	}
+	var listTestSchema, _ = graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"users": &graphql.Field{
+					Type: graphql.NewList(userType),
+					Resolve: func(_ graphql.ResolveParams) (interface{}, error) {
+						return users, nil
+					},
+				},
+				"userPointers": &graphql.Field{
+					Type: graphql.NewList(userType),
+					Resolve: func(_ graphql.ResolveParams) (interface{}, error) {
+						return []*listTestUser{&users[1], nil}, nil
+					},
+				},
+			},
+		}),
+	})
+	query := "{ users { id name friends { name } } userPointers { id } }"
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"users": []interface{}{
+				map[string]interface{}{
+					"id":   "1",
+					"name": "Alice",
+					"friends": []interface{}{
+						map[string]interface{}{"name": "Bob"},
+					},
+				},
+				map[string]interface{}{
+					"id":      "2",
+					"name":    "Bob",
+					"friends": []interface{}{},
+				},
+			},
+			"userPointers": []interface{}{
+				map[string]interface{}{"id": "2"},
+				nil,
+			},
+		},
+	}
+	result := g(t, graphql.Params{
+		Schema:        listTestSchema,
+		RequestString: query,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
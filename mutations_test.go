@@ -1,6 +1,7 @@
 package graphql_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -176,6 +177,34 @@ func TestMutations_ExecutionOrdering_EvaluatesMutationsSerially(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+func TestMutations_ResolvesTypenameOnTheMutationRoot(t *testing.T) {
+	doc := `mutation M {
+      __typename
+      first: immediatelyChangeTheNumber(newNumber: 1) {
+        __typename
+        theNumber
+      }
+    }`
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"__typename": "Mutation",
+			"first": map[string]interface{}{
+				"__typename": "NumberHolder",
+				"theNumber":  1,
+			},
+		},
+	}
+	ep := graphql.ExecuteParams{
+		Schema: mutationsTestSchema,
+		AST:    testutil.TestParse(t, doc),
+		Root:   newTestRoot(6),
+	}
+	result := testutil.TestExecute(t, ep)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func TestMutations_EvaluatesMutationsCorrectlyInThePresenceOfAFailedMutation(t *testing.T) {
 
 	root := newTestRoot(6)
@@ -250,3 +279,172 @@ func TestMutations_EvaluatesMutationsCorrectlyInThePresenceOfAFailedMutation(t *
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestMutations_ExecutionOrdering_FollowsTheSelectionOrderIncludingFragments(t *testing.T) {
+	var count int
+	calls := []string{}
+	increment := func(p graphql.ResolveParams) (interface{}, error) {
+		calls = append(calls, p.Info.Path.Key.(string))
+		count++
+		return count, nil
+	}
+	incrementLater := func(p graphql.ResolveParams) (interface{}, error) {
+		return func() (interface{}, error) {
+			return increment(p)
+		}, nil
+	}
+	counter := graphql.Fields{
+		"count": &graphql.Field{
+			Type: graphql.Int,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return count, nil
+			},
+		},
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: counter,
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"increment": &graphql.Field{
+					Type:    graphql.Int,
+					Resolve: increment,
+				},
+				"incrementLater": &graphql.Field{
+					Type:    graphql.Int,
+					Resolve: incrementLater,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// the fragment is defined after the fields following its spread, but its
+	// fields are still selected, and so executed, before them.
+	query := `
+      mutation {
+        ...First
+        third: incrementLater
+        ... on Mutation {
+          fourth: increment
+          fifth: incrementLater
+        }
+        sixth: increment
+      }
+      fragment First on Mutation {
+        first: increment
+        second: incrementLater
+      }
+    `
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"first":  1,
+			"second": 2,
+			"third":  3,
+			"fourth": 4,
+			"fifth":  5,
+			"sixth":  6,
+		},
+	}
+	expectedCalls := []string{"first", "second", "third", "fourth", "fifth", "sixth"}
+	for _, maxConcurrency := range []int{0, 4} {
+		count = 0
+		calls = []string{}
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  query,
+			MaxConcurrency: maxConcurrency,
+		})
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("Unexpected result with MaxConcurrency %v, Diff: %v", maxConcurrency, testutil.Diff(expected, result))
+		}
+		if !reflect.DeepEqual(expectedCalls, calls) {
+			t.Fatalf("Unexpected mutation order with MaxConcurrency %v, Diff: %v", maxConcurrency, testutil.Diff(expectedCalls, calls))
+		}
+	}
+}
+
+func TestMutations_UsesTheRootOfTheOperationType(t *testing.T) {
+	type testTransaction struct {
+		committed []string
+	}
+	txn := &testTransaction{}
+	var infoRoot interface{}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+				},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"commit": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"change": &graphql.ArgumentConfig{Type: graphql.String},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						infoRoot = p.Info.RootValue
+						txn, ok := p.Source.(*testTransaction)
+						if !ok {
+							return nil, fmt.Errorf("expected a transaction, got %T", p.Source)
+						}
+						txn.committed = append(txn.committed, p.Args["change"].(string))
+						return len(txn.committed), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	roots := graphql.OperationRoots{
+		Query:    map[string]interface{}{"greeting": "hello"},
+		Mutation: txn,
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ greeting }`,
+		RootValue:     roots,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"greeting": "hello",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `mutation { first: commit(change: "a") second: commit(change: "b") }`,
+		RootValue:     &roots,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"first":  1,
+			"second": 2,
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+	if expected := []string{"a", "b"}; !reflect.DeepEqual(expected, txn.committed) {
+		t.Fatalf("Unexpected changes, Diff: %v", testutil.Diff(expected, txn.committed))
+	}
+	if infoRoot != txn {
+		t.Fatalf("expected the resolve info to hold the mutation root, got %v", infoRoot)
+	}
+}
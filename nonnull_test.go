@@ -1,6 +1,7 @@
 package graphql_test
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 
@@ -1171,3 +1172,109 @@ func TestNonNull_NullsTheTopLevelIfSyncNonNullableFieldResolvesNull(t *testing.T
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestNonNull_ResolverErrorsPropagateToTheNearestNullableAncestor(t *testing.T) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if id := p.Source.(map[string]interface{})["id"]; id != 1 {
+						return nil, fmt.Errorf("user %v has no name", id)
+					}
+					return "Alice", nil
+				},
+			},
+		},
+	})
+	users := []interface{}{
+		map[string]interface{}{"id": 1},
+		map[string]interface{}{"id": 2},
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: userType,
+					Args: graphql.FieldConfigArgument{
+						"id": &graphql.ArgumentConfig{Type: graphql.Int},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return map[string]interface{}{"id": p.Args["id"]}, nil
+					},
+				},
+				"users": &graphql.Field{
+					Type: graphql.NewList(graphql.NewNonNull(userType)),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return users, nil
+					},
+				},
+				"strictUsers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return users, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	// the null stops at the nullable user field and the nullable users list
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ alice: user(id: 1) { name } bob: user(id: 2) { id name } users { name } }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"alice": map[string]interface{}{
+				"name": "Alice",
+			},
+			"bob":   nil,
+			"users": nil,
+		},
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "user 2 has no name",
+				Locations: []location.SourceLocation{{Line: 1, Column: 53}},
+				Path:      []interface{}{"bob", "name"},
+			},
+			{
+				Message:   "user 2 has no name",
+				Locations: []location.SourceLocation{{Line: 1, Column: 68}},
+				Path:      []interface{}{"users", 1, "name"},
+			},
+		},
+	}
+	sort.Sort(gqlerrors.FormattedErrors(expected.Errors))
+	sort.Sort(gqlerrors.FormattedErrors(result.Errors))
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	// nothing nullable is left above strictUsers, so the null reaches data
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ alice: user(id: 1) { name } strictUsers { name } }`,
+	})
+	expected = &graphql.Result{
+		Data: nil,
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:   "user 2 has no name",
+				Locations: []location.SourceLocation{{Line: 1, Column: 45}},
+				Path:      []interface{}{"strictUsers", 1, "name"},
+			},
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
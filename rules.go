@@ -138,8 +138,8 @@ func DefaultValuesOfCorrectTypeRule(context *ValidationContext) *ValidationRuleI
 							}
 							reportError(
 								context,
-								fmt.Sprintf(`Variable "$%v" has invalid default value: %v.%v`,
-									name, printer.Print(defaultValue), messagesStr),
+								fmt.Sprintf(`Variable "$%v" of type "%v" has invalid default value %v.%v`,
+									name, ttype, printer.Print(defaultValue), messagesStr),
 								[]ast.Node{defaultValue},
 							)
 						}
@@ -1243,8 +1243,8 @@ func PossibleFragmentSpreadsRule(context *ValidationContext) *ValidationRuleInst
 
 // ProvidedNonNullArgumentsRule Provided required arguments
 //
-// A field or directive is only valid if all required (non-null) field arguments
-// have been provided.
+// A field or directive is only valid if all required (non-null, without a
+// default value) field arguments have been provided.
 func ProvidedNonNullArgumentsRule(context *ValidationContext) *ValidationRuleInstance {
 
 	visitorOpts := &visitor.VisitorOptions{
@@ -1271,7 +1271,7 @@ func ProvidedNonNullArgumentsRule(context *ValidationContext) *ValidationRuleIns
 						for _, argDef := range fieldDef.Args {
 							argAST, _ := argASTMap[argDef.Name()]
 							if argAST == nil {
-								if argDefType, ok := argDef.Type.(*NonNull); ok {
+								if argDefType, ok := argDef.Type.(*NonNull); ok && argDef.DefaultValue == nil {
 									fieldName := ""
 									if fieldAST.Name != nil {
 										fieldName = fieldAST.Name.Value
@@ -1312,7 +1312,7 @@ func ProvidedNonNullArgumentsRule(context *ValidationContext) *ValidationRuleIns
 						for _, argDef := range directiveDef.Args {
 							argAST, _ := argASTMap[argDef.Name()]
 							if argAST == nil {
-								if argDefType, ok := argDef.Type.(*NonNull); ok {
+								if argDefType, ok := argDef.Type.(*NonNull); ok && argDef.DefaultValue == nil {
 									directiveName := ""
 									if directiveAST.Name != nil {
 										directiveName = directiveAST.Name.Value
@@ -1358,14 +1358,14 @@ func ScalarLeafsRule(context *ValidationContext) *ValidationRuleInstance {
 								if node.SelectionSet != nil {
 									reportError(
 										context,
-										fmt.Sprintf(`Field "%v" of type "%v" must not have a sub selection.`, nodeName, ttype),
+										fmt.Sprintf(`Field "%v" must not have a selection since type "%v" has no subfields.`, nodeName, ttype),
 										[]ast.Node{node.SelectionSet},
 									)
 								}
 							} else if node.SelectionSet == nil {
 								reportError(
 									context,
-									fmt.Sprintf(`Field "%v" of type "%v" must have a sub selection.`, nodeName, ttype),
+									fmt.Sprintf(`Field "%v" of type "%v" must have a selection of subfields. Did you mean "%v { ... }"?`, nodeName, ttype, nodeName),
 									[]ast.Node{node},
 								)
 							}
@@ -1591,7 +1591,7 @@ func UniqueVariableNamesRule(context *ValidationContext) *ValidationRuleInstance
 						if nameAST, ok := knownVariableNames[variableName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can only be one variable named "%v".`, variableName),
+								fmt.Sprintf(`There can be only one variable named "%v".`, variableName),
 								[]ast.Node{nameAST, variableNameAST},
 							)
 						} else {
@@ -1691,6 +1691,17 @@ func VariablesInAllowedPositionRule(context *ValidationContext) *ValidationRuleI
 										[]ast.Node{varDef, usage.Node},
 									)
 								}
+								// A variable given as the field of a OneOf input
+								// object must be non-null, as the field must be.
+								parentType, _ := GetNullable(usage.ParentType).(*InputObject)
+								if _, ok := varType.(*NonNull); varType != nil && !ok && parentType != nil && parentType.IsOneOf() {
+									reportError(
+										context,
+										fmt.Sprintf(`Variable "$%v" is of type "%v" but must be non-nullable to be used `+
+											`for OneOf Input Object "%v".`, varName, varType, parentType),
+										[]ast.Node{varDef, usage.Node},
+									)
+								}
 							}
 						}
 
@@ -1755,10 +1766,10 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 		itemType, _ := ttype.OfType.(Input)
 		if valueAST, ok := valueAST.(*ast.ListValue); ok {
 			messagesReduce := []string{}
-			for _, value := range valueAST.Values {
+			for i, value := range valueAST.Values {
 				_, messages := isValidLiteralValue(itemType, value)
-				for idx, message := range messages {
-					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
+				for _, message := range messages {
+					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, i+1, message))
 				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce
@@ -1795,6 +1806,10 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 				}
 			}
 		}
+		// Ensure exactly one field of a OneOf input object is given.
+		if ttype.IsOneOf() && len(fieldASTs) != 1 {
+			messagesReduce = append(messagesReduce, fmt.Sprintf(`Exactly one field must be given for OneOf type "%v".`, ttype.Name()))
+		}
 		return (len(messagesReduce) == 0), messagesReduce
 	case *Scalar:
 		if isNullish(ttype.ParseLiteral(valueAST)) {
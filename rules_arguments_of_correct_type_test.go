@@ -500,7 +500,24 @@ func TestValidate_ArgValuesOfCorrectType_InvalidListValue_IncorrectItemType(t *t
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"stringListArg\" has invalid value [\"one\", 2].\nIn element #1: Expected type \"String\", found 2.",
+				"Argument \"stringListArg\" has invalid value [\"one\", 2].\nIn element #2: Expected type \"String\", found 2.",
+				4, 47,
+			),
+		})
+}
+func TestValidate_ArgValuesOfCorrectType_InvalidListValue_ReportsThePositionOfEachIncorrectItem(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          complicatedArgs {
+            stringListArgField(stringListArg: [1, "two", 3])
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				"Argument \"stringListArg\" has invalid value [1, \"two\", 3]."+
+					"\nIn element #1: Expected type \"String\", found 1."+
+					"\nIn element #3: Expected type \"String\", found 3.",
 				4, 47,
 			),
 		})
@@ -742,7 +759,7 @@ func TestValidate_ArgValuesOfCorrectType_InvalidInputObjectValue_PartialObject_I
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"complexArg\" has invalid value {stringListField: [\"one\", 2], requiredField: true}.\nIn field \"stringListField\": In element #1: Expected type \"String\", found 2.",
+				"Argument \"complexArg\" has invalid value {stringListField: [\"one\", 2], requiredField: true}.\nIn field \"stringListField\": In element #2: Expected type \"String\", found 2.",
 				4, 41,
 			),
 		})
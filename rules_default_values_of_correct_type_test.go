@@ -63,14 +63,14 @@ func TestValidate_VariableDefaultValuesOfCorrectType_VariablesWithInvalidDefault
       }
     `,
 		[]gqlerrors.FormattedError{
-			testutil.RuleError(`Variable "$a" has invalid default value: "one".`+
+			testutil.RuleError(`Variable "$a" of type "Int" has invalid default value "one".`+
 				"\nExpected type \"Int\", found \"one\".",
 				3, 19),
-			testutil.RuleError(`Variable "$b" has invalid default value: 4.`+
+			testutil.RuleError(`Variable "$b" of type "String" has invalid default value 4.`+
 				"\nExpected type \"String\", found 4.",
 				4, 22),
 			testutil.RuleError(
-				`Variable "$c" has invalid default value: "notverycomplex".`+
+				`Variable "$c" of type "ComplexInput" has invalid default value "notverycomplex".`+
 					"\nExpected \"ComplexInput\", found not an object.",
 				5, 28),
 		})
@@ -83,7 +83,7 @@ func TestValidate_VariableDefaultValuesOfCorrectType_ComplexVariablesMissingRequ
     `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				`Variable "$a" has invalid default value: {intField: 3}.`+
+				`Variable "$a" of type "ComplexInput" has invalid default value {intField: 3}.`+
 					"\nIn field \"requiredField\": Expected \"Boolean!\", found null.",
 				2, 53),
 		})
@@ -96,8 +96,8 @@ func TestValidate_VariableDefaultValuesOfCorrectType_ListVariablesWithInvalidIte
     `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				`Variable "$a" has invalid default value: ["one", 2].`+
-					"\nIn element #1: Expected type \"String\", found 2.",
+				`Variable "$a" of type "[String]" has invalid default value ["one", 2].`+
+					"\nIn element #2: Expected type \"String\", found 2.",
 				2, 40),
 		})
 }
@@ -105,3 +105,22 @@ func TestValidate_VariableDefaultValuesOfCorrectType_ListVariablesWithInvalidIte
 func TestValidate_VariableDefaultValuesOfCorrectType_InvalidNonNull(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.DefaultValuesOfCorrectTypeRule, `query($g:e!){a}`)
 }
+func TestValidate_VariableDefaultValuesOfCorrectType_RequiredVariableWithInvalidDefaultValue(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query InvalidRequiredDefault($x: Int! = "foo") {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Variable "$x" of type "Int!" is required and will not `+
+					`use the default value. Perhaps you meant to use type "Int".`,
+				2, 47,
+			),
+			testutil.RuleError(
+				`Variable "$x" of type "Int!" has invalid default value "foo".`+
+					"\nExpected type \"Int\", found \"foo\".",
+				2, 47,
+			),
+		})
+}
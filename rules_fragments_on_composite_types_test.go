@@ -85,3 +85,19 @@ func TestValidate_FragmentsOnCompositeTypes_ScalarIsInvalidInlineFragmentType(t
 		testutil.RuleError(`Fragment cannot condition on non composite type "String".`, 3, 16),
 	})
 }
+func TestValidate_FragmentsOnCompositeTypes_OnlyReportsNonCompositeConditions(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.FragmentsOnCompositeTypesRule, `
+      {
+        dog {
+          ...dogFragment
+          ... on Dog { name }
+          ... on String { length }
+        }
+      }
+      fragment dogFragment on Dog { barks }
+      fragment f on String { length }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment cannot condition on non composite type "String".`, 6, 18),
+		testutil.RuleError(`Fragment "f" cannot condition on non composite type "String".`, 10, 21),
+	})
+}
@@ -133,3 +133,27 @@ func TestValidate_KnownArgumentNames_UnknownArgsDeeply(t *testing.T) {
 		testutil.RuleError(`Unknown argument "unknown" on field "doesKnowCommand" of type "Dog".`, 9, 31),
 	})
 }
+func TestValidate_KnownArgumentNames_SuggestsArgumentsOfCustomFieldsAndDirectives(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+      directive @cached(maxAge: Int) on FIELD
+      type Query { field(knownArg: Int): String }
+    `)
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.KnownArgumentNamesRule, `
+      {
+        field(knownArg: 1) @cached(maxAge: 60)
+      }
+    `)
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.KnownArgumentNamesRule, `
+      {
+        field(unknownArg: 1) @cached(maxage: 60)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Unknown argument "unknownArg" on field "field" of type "Query". `+
+			`Did you mean "knownArg"?`, 3, 15),
+		testutil.RuleError(`Unknown argument "maxage" on directive "@cached". `+
+			`Did you mean "maxAge"?`, 3, 38),
+	})
+}
@@ -53,3 +53,18 @@ func TestValidate_KnownFragmentNames_UnknownFragmentNamesAreInvalid(t *testing.T
 		testutil.RuleError(`Unknown fragment "UnknownFragment3".`, 12, 12),
 	})
 }
+func TestValidate_KnownFragmentNames_KnownAndUnknownFragmentNamesInTheSameSelectionSet(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.KnownFragmentNamesRule, `
+      {
+        human(id: 4) {
+          ...HumanFields
+          ...undefinedFragment
+        }
+      }
+      fragment HumanFields on Human {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Unknown fragment "undefinedFragment".`, 5, 14),
+	})
+}
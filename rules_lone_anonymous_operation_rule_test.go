@@ -81,3 +81,32 @@ func TestValidate_AnonymousOperationMustBeAlone_AnonOperationWithASubscription(t
 		testutil.RuleError(`This anonymous operation must be the only defined operation.`, 2, 7),
 	})
 }
+func TestValidate_AnonymousOperationMustBeAlone_AnonOperationWithANamedQueryIsRejectedBeforeExecution(t *testing.T) {
+	for _, operationName := range []string{"", "Named"} {
+		result := graphql.Do(graphql.Params{
+			Schema:        testutil.StarWarsSchema,
+			RequestString: `{ hero { name } } query Named { hero { id } }`,
+			OperationName: operationName,
+		})
+		expected := &graphql.Result{
+			Errors: []gqlerrors.FormattedError{
+				testutil.RuleError(`This anonymous operation must be the only defined operation.`, 1, 1),
+			},
+		}
+		if !testutil.EqualResults(expected, result) {
+			t.Fatalf("operation name %q: Unexpected result, Diff: %v", operationName, testutil.Diff(expected, result))
+		}
+	}
+}
+func TestValidate_AnonymousOperationMustBeAlone_AnonOperationWithANamedQuery(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.LoneAnonymousOperationRule, `
+      {
+        fieldA
+      }
+      query Foo {
+        fieldB
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`This anonymous operation must be the only defined operation.`, 2, 7),
+	})
+}
@@ -176,3 +176,21 @@ func TestValidate_NoCircularFragmentSpreads_NoSpreadingItselfDeeplyAndImmediatel
 			4, 41),
 	})
 }
+func TestValidate_NoCircularFragmentSpreads_CyclesAreRejectedBeforeExecution(t *testing.T) {
+	result := graphql.Do(graphql.Params{
+		Schema: testutil.StarWarsSchema,
+		RequestString: `
+      { hero { ...fragA } }
+      fragment fragA on Character { name ...fragB }
+      fragment fragB on Character { ...fragA }
+    `,
+	})
+	expected := &graphql.Result{
+		Errors: []gqlerrors.FormattedError{
+			testutil.RuleError(`Cannot spread fragment "fragA" within itself via fragB.`, 3, 42, 4, 37),
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
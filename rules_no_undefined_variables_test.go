@@ -263,3 +263,26 @@ func TestValidate_NoUndefinedVariables_VaMultipleUndefinedVariablesProduceMultip
 		testutil.RuleError(`Variable "$b" is not defined by operation "Bar".`, 11, 26, 5, 7),
 	})
 }
+func TestValidate_NoUndefinedVariables_VariableInNestedFragmentNotDefinedByOneOfManyOperations(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo($a: String) {
+        ...FragA
+      }
+      query Bar {
+        ...FragA
+      }
+      query Baz($a: String) {
+        field {
+          ...FragA
+        }
+      }
+      fragment FragA on Type {
+        ...FragB
+      }
+      fragment FragB on Type {
+        field(a: $a)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$a" is not defined by operation "Bar".`, 17, 18, 5, 7),
+	})
+}
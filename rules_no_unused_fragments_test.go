@@ -136,3 +136,26 @@ func TestValidate_NoUnusedFragments_ContainsUnknownAndUndefFragments(t *testing.
 		testutil.RuleError(`Fragment "foo" is never used.`, 7, 7),
 	})
 }
+func TestValidate_NoUnusedFragments_FragmentOnlySpreadByAnUnusedFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUnusedFragmentsRule, `
+      {
+        human(id: 4) {
+          ... on Human {
+            ...HumanFields
+          }
+        }
+      }
+      fragment HumanFields on Human {
+        name
+      }
+      fragment Unused on Human {
+        ...OnlyUsedByUnused
+      }
+      fragment OnlyUsedByUnused on Human {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment "Unused" is never used.`, 12, 7),
+		testutil.RuleError(`Fragment "OnlyUsedByUnused" is never used.`, 15, 7),
+	})
+}
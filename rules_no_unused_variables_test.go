@@ -187,3 +187,27 @@ func TestValidate_NoUnusedVariables_VariableNotUsedByFragmentUsedByOtherOperatio
 		testutil.RuleError(`Variable "$a" is never used in operation "Bar".`, 5, 17),
 	})
 }
+func TestValidate_NoUnusedVariables_UsesVariablesInDirectivesWithinFragments(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($a: Boolean, $b: Boolean) {
+        ...FragA
+      }
+      fragment FragA on Type {
+        field @include(if: $a) {
+          ... on Type @skip(if: $b) {
+            field
+          }
+        }
+      }
+    `)
+}
+func TestValidate_NoUnusedVariables_UsesVariablesNestedInListAndObjectValues(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($a: String, $b: String) {
+        ...FragA
+      }
+      fragment FragA on Type {
+        field(list: [$a], object: {b: $b})
+      }
+    `)
+}
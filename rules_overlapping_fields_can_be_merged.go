@@ -199,6 +199,11 @@ func (rule *overlappingFieldsCanBeMergedRule) collectConflictsBetweenFieldsAndFr
 
 	fieldsInfo2 := rule.getReferencedFieldsAndFragmentNames(fragment)
 
+	// Do not compare a fragment's fields to themselves.
+	if fieldsInfo == fieldsInfo2 {
+		return conflicts
+	}
+
 	// (D) First collect any conflicts between the provided collection of fields
 	// and the collection of fields represented by the given fragment.
 	conflicts = rule.collectConflictsBetween(conflicts, areMutuallyExclusive, fieldsInfo, fieldsInfo2)
@@ -206,7 +211,12 @@ func (rule *overlappingFieldsCanBeMergedRule) collectConflictsBetweenFieldsAndFr
 	// (E) Then collect any conflicts between the provided collection of fields
 	// and any fragment names found in the given fragment.
 	for _, fragmentName2 := range fieldsInfo2.fragmentNames {
-		conflicts = rule.collectConflictsBetweenFieldsAndFragment(conflicts, areMutuallyExclusive, fieldsInfo2, fragmentName2)
+		// Memoize so that fragment cycles do not recurse forever.
+		if rule.comparedSet.Has(fragmentName2, fragmentName, areMutuallyExclusive) {
+			continue
+		}
+		rule.comparedSet.Add(fragmentName2, fragmentName, areMutuallyExclusive)
+		conflicts = rule.collectConflictsBetweenFieldsAndFragment(conflicts, areMutuallyExclusive, fieldsInfo, fragmentName2)
 	}
 
 	return conflicts
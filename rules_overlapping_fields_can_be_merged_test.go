@@ -900,3 +900,15 @@ func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_Igno
 func TestValidate_OverlappingFieldsCanBeMerged_NilCrash(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.OverlappingFieldsCanBeMergedRule, `subscription {e}`)
 }
+
+func TestValidate_OverlappingFieldsCanBeMerged_DoesNotInfiniteLoopOnFragmentCycles(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        dog {
+          ...fragA
+        }
+      }
+      fragment fragA on Dog { name ...fragB }
+      fragment fragB on Dog { nickname ...fragA }
+    `)
+}
@@ -180,3 +180,43 @@ func TestValidate_PossibleFragmentSpreads_InterfaceIntoNonOverlappingUnion(t *te
 			`type "HumanOrAlien" can never be of type "Pet".`, 2, 62),
 	})
 }
+
+func TestValidate_PossibleFragmentSpreads_CommentsAndUsers(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+      interface Node { id: ID }
+      interface Authored { author: User }
+      type User implements Node { id: ID name: String }
+      type Comment implements Node & Authored { id: ID author: User body: String }
+      type Post implements Node & Authored { id: ID author: User }
+      union Content = Comment | Post
+      union Actor = User
+      type Query { comment: Comment content: Content node: Node }
+    `)
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.PossibleFragmentSpreadsRule, `
+      {
+        comment { ...nodeFields ...contentFields ... on Authored { author { id } } }
+        node { ...userFields ... on Content { __typename } ... on Actor { __typename } }
+      }
+      fragment nodeFields on Node { id }
+      fragment contentFields on Content { __typename }
+      fragment userFields on User { name }
+    `)
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.PossibleFragmentSpreadsRule, `
+      {
+        comment { ...userFields ... on Post { id } }
+        content { ...actorFields }
+      }
+      fragment userFields on User { name }
+      fragment actorFields on Actor { __typename }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment "userFields" cannot be spread here as objects of `+
+			`type "Comment" can never be of type "User".`, 3, 19),
+		testutil.RuleError(`Fragment cannot be spread here as objects of `+
+			`type "Comment" can never be of type "Post".`, 3, 33),
+		testutil.RuleError(`Fragment "actorFields" cannot be spread here as objects of `+
+			`type "Content" can never be of type "Actor".`, 4, 19),
+	})
+}
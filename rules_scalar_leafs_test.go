@@ -21,7 +21,7 @@ func TestValidate_ScalarLeafs_ObjectTypeMissingSelection(t *testing.T) {
         human
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "human" of type "Human" must have a sub selection.`, 3, 9),
+		testutil.RuleError(`Field "human" of type "Human" must have a selection of subfields. Did you mean "human { ... }"?`, 3, 9),
 	})
 }
 func TestValidate_ScalarLeafs_InterfaceTypeMissingSelection(t *testing.T) {
@@ -30,7 +30,7 @@ func TestValidate_ScalarLeafs_InterfaceTypeMissingSelection(t *testing.T) {
         human { pets }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "pets" of type "[Pet]" must have a sub selection.`, 3, 17),
+		testutil.RuleError(`Field "pets" of type "[Pet]" must have a selection of subfields. Did you mean "pets { ... }"?`, 3, 17),
 	})
 }
 func TestValidate_ScalarLeafs_ValidScalarSelectionWithArgs(t *testing.T) {
@@ -47,7 +47,7 @@ func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedOnBoolean(t *testing.T) {
         barks { sinceWhen }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "barks" of type "Boolean" must not have a sub selection.`, 3, 15),
+		testutil.RuleError(`Field "barks" must not have a selection since type "Boolean" has no subfields.`, 3, 15),
 	})
 }
 func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedOnEnum(t *testing.T) {
@@ -56,7 +56,7 @@ func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedOnEnum(t *testing.T) {
         furColor { inHexdec }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "furColor" of type "FurColor" must not have a sub selection.`, 3, 18),
+		testutil.RuleError(`Field "furColor" must not have a selection since type "FurColor" has no subfields.`, 3, 18),
 	})
 }
 func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithArgs(t *testing.T) {
@@ -65,7 +65,7 @@ func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithArgs(t *testing.T) {
         doesKnowCommand(dogCommand: SIT) { sinceWhen }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "doesKnowCommand" of type "Boolean" must not have a sub selection.`, 3, 42),
+		testutil.RuleError(`Field "doesKnowCommand" must not have a selection since type "Boolean" has no subfields.`, 3, 42),
 	})
 }
 func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithDirectives(t *testing.T) {
@@ -74,7 +74,7 @@ func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithDirectives(t *testing
         name @include(if: true) { isAlsoHumanName }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "name" of type "String" must not have a sub selection.`, 3, 33),
+		testutil.RuleError(`Field "name" must not have a selection since type "String" has no subfields.`, 3, 33),
 	})
 }
 func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithDirectivesAndArgs(t *testing.T) {
@@ -83,6 +83,61 @@ func TestValidate_ScalarLeafs_ScalarSelectionNotAllowedWithDirectivesAndArgs(t *
         doesKnowCommand(dogCommand: SIT) @include(if: true) { sinceWhen }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Field "doesKnowCommand" of type "Boolean" must not have a sub selection.`, 3, 61),
+		testutil.RuleError(`Field "doesKnowCommand" must not have a selection since type "Boolean" has no subfields.`, 3, 61),
+	})
+}
+func TestValidate_ScalarLeafs_UnwrapsListAndNonNullTypes(t *testing.T) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"tags": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			},
+		},
+	})
+	userType.AddFieldConfig("friends", &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: userType,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.ScalarLeafsRule, `
+      {
+        user {
+          tags
+          friends { name }
+        }
+      }
+    `)
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.ScalarLeafsRule, `
+      {
+        user {
+          tags { length }
+          friends
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "tags" must not have a selection since type "[String!]!" has no subfields.`, 4, 16),
+		testutil.RuleError(`Field "friends" of type "[User!]!" must have a selection of subfields. Did you mean "friends { ... }"?`, 5, 11),
+	})
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.ScalarLeafsRule, `
+      {
+        user
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "user" of type "User" must have a selection of subfields. Did you mean "user { ... }"?`, 3, 9),
 	})
 }
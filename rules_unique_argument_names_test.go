@@ -110,3 +110,27 @@ func TestValidate_UniqueArgumentNames_ManyDuplicateDirectiveArguments(t *testing
 		testutil.RuleError(`There can be only one argument named "arg1".`, 3, 26, 3, 56),
 	})
 }
+func TestValidate_UniqueArgumentNames_DuplicateFieldArgumentsAreRejectedBeforeExecution(t *testing.T) {
+	result := graphql.Do(graphql.Params{
+		Schema:        testutil.StarWarsSchema,
+		RequestString: `{ human(id: "1000", id: "1001") { name } }`,
+	})
+	expected := &graphql.Result{
+		Errors: []gqlerrors.FormattedError{
+			testutil.RuleError(`There can be only one argument named "id".`, 1, 9, 1, 21),
+		},
+	}
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+func TestValidate_UniqueArgumentNames_DuplicateArgumentsOnFieldAndItsDirective(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueArgumentNamesRule, `
+      {
+        field(a: 1, a: 2) @directive(a: 1, a: 2)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one argument named "a".`, 3, 15, 3, 21),
+		testutil.RuleError(`There can be only one argument named "a".`, 3, 38, 3, 44),
+	})
+}
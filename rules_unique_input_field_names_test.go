@@ -63,3 +63,12 @@ func TestValidate_UniqueInputFieldNames_ManyDuplicateInputObjectFields(t *testin
 		testutil.RuleError(`There can be only one input field named "f1".`, 3, 22, 3, 48),
 	})
 }
+func TestValidate_UniqueInputFieldNames_DuplicateInputObjectFieldsWithinAList(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueInputFieldNamesRule, `
+      {
+        field(arg: [{a: 1, a: 2}])
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one input field named "a".`, 3, 22, 3, 28),
+	})
+}
@@ -244,3 +244,45 @@ func TestValidate_VariablesInAllowedPosition_StringToNonNullableBooleanInDirecti
 			`expecting type "Boolean!".`, 2, 19, 3, 26),
 	})
 }
+func TestValidate_VariablesInAllowedPosition_NestedListsAndNonNulls(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"matrix": &graphql.Field{
+					Type: graphql.Int,
+					Args: graphql.FieldConfigArgument{
+						"m": &graphql.ArgumentConfig{
+							Type: graphql.NewList(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Int)))),
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.VariablesInAllowedPositionRule, `
+      query Query($a: [[Int!]!], $b: [[Int!]!]!, $c: [Int!]!, $d: Int!) {
+        a: matrix(m: $a)
+        b: matrix(m: $b)
+        c: matrix(m: [$c])
+        d: matrix(m: [[$d]])
+      }
+    `)
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.VariablesInAllowedPositionRule, `
+      query Query($rows: [[Int!]], $items: [[Int]!], $flat: [Int!]) {
+        rows: matrix(m: $rows)
+        items: matrix(m: $items)
+        flat: matrix(m: $flat)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$rows" of type "[[Int!]]" used in position `+
+			`expecting type "[[Int!]!]".`, 2, 19, 3, 25),
+		testutil.RuleError(`Variable "$items" of type "[[Int]!]" used in position `+
+			`expecting type "[[Int!]!]".`, 2, 36, 4, 26),
+		testutil.RuleError(`Variable "$flat" of type "[Int!]" used in position `+
+			`expecting type "[[Int!]!]".`, 2, 54, 5, 25),
+	})
+}
@@ -1,9 +1,12 @@
 package graphql
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/graphql-go/graphql/language/ast"
@@ -142,6 +145,20 @@ func coerceInt(value interface{}) interface{} {
 			return nil
 		}
 		return coerceInt(*value)
+	case json.Number:
+		if val, err := value.Int64(); err == nil {
+			return coerceInt(val)
+		}
+		val, err := value.Float64()
+		if err != nil {
+			return nil
+		}
+		return coerceInt(val)
+	case *json.Number:
+		if value == nil {
+			return nil
+		}
+		return coerceInt(*value)
 	}
 
 	// If the value cannot be transformed into an int, return nil instead of '0'
@@ -149,18 +166,35 @@ func coerceInt(value interface{}) interface{} {
 	return nil
 }
 
+// parseInt coerces input values to an Int. Unlike coerceInt, which truncates
+// fractional values when serializing, it rejects values that are not whole
+// numbers.
+func parseInt(value interface{}) interface{} {
+	switch f := coerceFloat(value).(type) {
+	case float32:
+		if float64(f) != math.Trunc(float64(f)) {
+			return nil
+		}
+	case float64:
+		if f != math.Trunc(f) {
+			return nil
+		}
+	}
+	return coerceInt(value)
+}
+
 // Int is the GraphQL Integer type definition.
 var Int = NewScalar(ScalarConfig{
 	Name: "Int",
 	Description: "The `Int` scalar type represents non-fractional signed whole numeric " +
 		"values. Int can represent values between -(2^31) and 2^31 - 1. ",
 	Serialize:  coerceInt,
-	ParseValue: coerceInt,
+	ParseValue: parseInt,
 	ParseLiteral: func(valueAST ast.Value) interface{} {
 		switch valueAST := valueAST.(type) {
 		case *ast.IntValue:
-			if intValue, err := strconv.Atoi(valueAST.Value); err == nil {
-				return intValue
+			if intValue, err := strconv.ParseInt(valueAST.Value, 10, 32); err == nil {
+				return int(intValue)
 			}
 		}
 		return nil
@@ -274,6 +308,17 @@ func coerceFloat(value interface{}) interface{} {
 			return nil
 		}
 		return coerceFloat(*value)
+	case json.Number:
+		val, err := value.Float64()
+		if err != nil {
+			return nil
+		}
+		return val
+	case *json.Number:
+		if value == nil {
+			return nil
+		}
+		return coerceFloat(*value)
 	}
 
 	// If the value cannot be transformed into an float, return nil instead of '0.0'
@@ -281,6 +326,28 @@ func coerceFloat(value interface{}) interface{} {
 	return nil
 }
 
+// NonFiniteFloatPolicy decides how NaN and infinite float values, which JSON
+// can not represent, are serialized.
+type NonFiniteFloatPolicy int
+
+const (
+	// NonFiniteFloatAsNull serializes NaN and infinite floats as null.
+	NonFiniteFloatAsNull NonFiniteFloatPolicy = iota
+	// NonFiniteFloatAsError reports NaN and infinite floats as field errors.
+	NonFiniteFloatAsError
+)
+
+// isNonFiniteFloat reports whether value is a NaN or infinite float.
+func isNonFiniteFloat(value interface{}) bool {
+	switch value := value.(type) {
+	case float32:
+		return math.IsNaN(float64(value)) || math.IsInf(float64(value), 0)
+	case float64:
+		return math.IsNaN(value) || math.IsInf(value, 0)
+	}
+	return false
+}
+
 // Float is the GraphQL float type definition.
 var Float = NewScalar(ScalarConfig{
 	Name: "Float",
@@ -311,6 +378,7 @@ func coerceString(value interface{}) interface{} {
 		}
 		return *v
 	}
+	// %v formats fmt.Stringer values with their String method
 	return fmt.Sprintf("%v", value)
 }
 
@@ -549,6 +617,11 @@ func unserializeDateTime(value interface{}) interface{} {
 		return unserializeDateTime([]byte(*value))
 	case time.Time:
 		return value
+	case *time.Time:
+		if value == nil {
+			return nil
+		}
+		return *value
 	default:
 		return nil
 	}
@@ -568,3 +641,159 @@ var DateTime = NewScalar(ScalarConfig{
 		return nil
 	},
 })
+
+// serializeJSON passes values through, to be encoded along with the rest of
+// the result. Values that are already encoded, as a json.RawMessage, are
+// passed through as they are and are not encoded again.
+func serializeJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *json.RawMessage:
+		if value == nil {
+			return nil
+		}
+		return *value
+	default:
+		return value
+	}
+}
+
+var JSON = newUntypedScalar(ScalarConfig{
+	Name: "JSON",
+	Description: "The `JSON` scalar type represents arbitrary JSON values." +
+		" Values already encoded as JSON are passed through as they are",
+	Serialize: serializeJSON,
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+})
+
+// decimalPrecision is the precision, in bits, of the big.Float values the
+// Decimal scalar parses.
+const decimalPrecision = 128
+
+// maxDecimalExponent is the largest decimal exponent, positive or negative,
+// of the decimals the Decimal scalar accepts. It keeps a value such as
+// "1e100000000" from being expanded to 100 million digits when it is parsed
+// or serialized.
+const maxDecimalExponent = 1000
+
+// parseDecimal parses a decimal string such as "12345.6789", returning nil if
+// it is not a finite decimal number within the range of maxDecimalExponent.
+func parseDecimal(value string) *big.Float {
+	// the exponent is checked first, as parsing a large one is slow
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(value[i+1:])
+		if err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return nil
+		}
+	}
+	decimal, _, err := big.ParseFloat(value, 10, decimalPrecision, big.ToNearestEven)
+	if err != nil || !decimalInRange(decimal) {
+		return nil
+	}
+	return decimal
+}
+
+// decimalInRange returns true if the decimal is finite, and its exponent
+// within the range of maxDecimalExponent. The binary exponent is compared
+// with a bound slightly above maxDecimalExponent times log2(10).
+func decimalInRange(decimal *big.Float) bool {
+	if decimal.IsInf() {
+		return false
+	}
+	exp := decimal.MantExp(nil)
+	return exp <= 4*maxDecimalExponent && exp >= -4*maxDecimalExponent
+}
+
+// toDecimal converts a number, or a string holding one, to a *big.Float. It
+// returns nil if the value is not a finite number.
+func toDecimal(value interface{}) *big.Float {
+	switch value := value.(type) {
+	case *big.Float:
+		if value == nil || !decimalInRange(value) {
+			return nil
+		}
+		return value
+	case big.Float:
+		return toDecimal(&value)
+	case string:
+		return parseDecimal(value)
+	case *string:
+		if value == nil {
+			return nil
+		}
+		return parseDecimal(*value)
+	case json.Number:
+		return parseDecimal(value.String())
+	case float64:
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil
+		}
+		// the shortest representation of the float, so that 0.1 does not
+		// become 0.1000000000000000055511151231257827
+		return parseDecimal(strconv.FormatFloat(value, 'g', -1, 64))
+	case float32:
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return nil
+		}
+		return parseDecimal(strconv.FormatFloat(float64(value), 'g', -1, 32))
+	case int:
+		return new(big.Float).SetPrec(decimalPrecision).SetInt64(int64(value))
+	case int8:
+		return new(big.Float).SetPrec(decimalPrecision).SetInt64(int64(value))
+	case int16:
+		return new(big.Float).SetPrec(decimalPrecision).SetInt64(int64(value))
+	case int32:
+		return new(big.Float).SetPrec(decimalPrecision).SetInt64(int64(value))
+	case int64:
+		return new(big.Float).SetPrec(decimalPrecision).SetInt64(value)
+	case uint:
+		return new(big.Float).SetPrec(decimalPrecision).SetUint64(uint64(value))
+	case uint8:
+		return new(big.Float).SetPrec(decimalPrecision).SetUint64(uint64(value))
+	case uint16:
+		return new(big.Float).SetPrec(decimalPrecision).SetUint64(uint64(value))
+	case uint32:
+		return new(big.Float).SetPrec(decimalPrecision).SetUint64(uint64(value))
+	case uint64:
+		return new(big.Float).SetPrec(decimalPrecision).SetUint64(value)
+	}
+	return nil
+}
+
+// serializeDecimal encodes a decimal as the shortest string parsing back to
+// the same value, so that it is not rounded to a float64 along the way.
+func serializeDecimal(value interface{}) interface{} {
+	decimal := toDecimal(value)
+	if decimal == nil {
+		return nil
+	}
+	return decimal.Text('f', -1)
+}
+
+// unserializeDecimal converts an input value to a *big.Float.
+func unserializeDecimal(value interface{}) interface{} {
+	if decimal := toDecimal(value); decimal != nil {
+		return decimal
+	}
+	return nil
+}
+
+var Decimal = NewScalar(ScalarConfig{
+	Name: "Decimal",
+	Description: "The `Decimal` scalar type represents an arbitrary precision decimal number." +
+		" The Decimal is serialized as a string, such as \"12345.6789\", to keep its precision",
+	Serialize:  serializeDecimal,
+	ParseValue: unserializeDecimal,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return unserializeDecimal(valueAST.Value)
+		case *ast.IntValue:
+			return unserializeDecimal(valueAST.Value)
+		case *ast.FloatValue:
+			return unserializeDecimal(valueAST.Value)
+		}
+		return nil
+	},
+})
@@ -1,12 +1,18 @@
 package graphql_test
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/testutil"
 )
 
 func TestTypeSystem_Scalar_ParseValueOutputDateTime(t *testing.T) {
@@ -17,6 +23,9 @@ func TestTypeSystem_Scalar_ParseValueOutputDateTime(t *testing.T) {
 		{(*string)(nil), nil},
 		{"2017-07-23", nil},
 		{"2017-07-23T03:46:56.647Z", t1},
+		{t1, t1},
+		{&t1, t1},
+		{(*time.Time)(nil), nil},
 	}
 	for _, test := range tests {
 		val := graphql.DateTime.ParseValue(test.Value)
@@ -52,3 +61,215 @@ func TestTypeSystem_Scalar_ParseLiteralOutputDateTime(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeSystem_Scalar_DateTimeRoundTrips(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"nextDay": &graphql.Field{
+					Type: graphql.DateTime,
+					Args: graphql.FieldConfigArgument{
+						"date": &graphql.ArgumentConfig{Type: graphql.DateTime},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						date := p.Args["date"].(time.Time)
+						return date.Add(24 * time.Hour), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"nextDay": "2017-07-24T03:46:56.647Z",
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ nextDay(date: "2017-07-23T03:46:56.647Z") }`,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($date: DateTime) { nextDay(date: $date) }`,
+		VariableValues: map[string]interface{}{"date": "2017-07-23T03:46:56.647Z"},
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($date: DateTime) { nextDay(date: $date) }`,
+		VariableValues: map[string]interface{}{"date": "2017-07-23"},
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: "Variable \"$date\" got invalid value \"2017-07-23\".\nExpected type \"DateTime\", found \"2017-07-23\".",
+			Locations: []location.SourceLocation{
+				{Line: 1, Column: 8},
+			},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+}
+
+func TestTypeSystem_Scalar_DecimalRoundTrips(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"double": &graphql.Field{
+					Type: graphql.Decimal,
+					Args: graphql.FieldConfigArgument{
+						"amount": &graphql.ArgumentConfig{Type: graphql.Decimal},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						amount := p.Args["amount"].(*big.Float)
+						return new(big.Float).Add(amount, amount), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"double": "24691.3578",
+		},
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ double(amount: "12345.6789") }`,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ double(amount: 12345.6789) }`,
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($amount: Decimal) { double(amount: $amount) }`,
+		VariableValues: map[string]interface{}{"amount": "12345.6789"},
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($amount: Decimal) { double(amount: $amount) }`,
+		VariableValues: map[string]interface{}{"amount": "abc"},
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: "Variable \"$amount\" got invalid value \"abc\".\nExpected type \"Decimal\", found \"abc\".",
+			Locations: []location.SourceLocation{
+				{Line: 1, Column: 8},
+			},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+
+	if parsed := graphql.Decimal.ParseLiteral(&ast.StringValue{Value: "abc"}); parsed != nil {
+		t.Fatalf("expected \"abc\" not to parse as a decimal, got %v", parsed)
+	}
+}
+
+func TestTypeSystem_Scalar_DecimalSerializesNumbersAndRejectsHugeExponents(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected interface{}
+	}{
+		{float64(0.1), "0.1"},
+		{float32(2.5), "2.5"},
+		{42, "42"},
+		{int64(-7), "-7"},
+		{uint64(math.MaxUint64), "18446744073709551615"},
+		{json.Number("1.50"), "1.5"},
+		{"1e10", "10000000000"},
+		{"1.5E+3", "1500"},
+		{"1e100000000", nil},
+		{"-1e-100000000", nil},
+		{new(big.Float).SetMantExp(big.NewFloat(1), 1<<20), nil},
+		{math.NaN(), nil},
+		{math.Inf(1), nil},
+		{true, nil},
+	}
+	for _, test := range tests {
+		if serialized := graphql.Decimal.Serialize(test.value); serialized != test.expected {
+			t.Fatalf("expected %#v to serialize to %#v, got %#v", test.value, test.expected, serialized)
+		}
+	}
+}
+
+func TestTypeSystem_Scalar_IDRoundTripsUUIDStrings(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"node": &graphql.Field{
+					Type: graphql.ID,
+					Args: graphql.FieldConfigArgument{
+						"id": &graphql.ArgumentConfig{Type: graphql.ID},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Args["id"], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	uuid := "123e4567-e89b-12d3-a456-426614174000"
+	tests := []struct {
+		query     string
+		variables map[string]interface{}
+		expected  string
+	}{
+		{`{ node(id: "` + uuid + `") }`, nil, uuid},
+		{`query ($id: ID) { node(id: $id) }`, map[string]interface{}{"id": uuid}, uuid},
+		{`{ node(id: 4) }`, nil, "4"},
+		{`query ($id: ID) { node(id: $id) }`, map[string]interface{}{"id": 4}, "4"},
+		{`{ node(id: "00042") }`, nil, "00042"},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  test.query,
+			VariableValues: test.variables,
+		})
+		expected := &graphql.Result{
+			Data: map[string]interface{}{
+				"node": test.expected,
+			},
+		}
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+		}
+	}
+}
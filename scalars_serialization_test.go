@@ -1,6 +1,9 @@
 package graphql_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"math"
 	"reflect"
 	"testing"
@@ -34,6 +37,20 @@ type boolSerializationTest struct {
 	Expected bool
 }
 
+type testColor int
+
+func (c testColor) String() string {
+	return [...]string{"red", "green", "blue"}[c]
+}
+
+type testPointerStringer struct {
+	name string
+}
+
+func (s *testPointerStringer) String() string {
+	return "pointer " + s.name
+}
+
 func TestTypeSystem_Scalar_SerializesOutputInt(t *testing.T) {
 	tests := []intSerializationTest{
 		{1, 1},
@@ -139,6 +156,8 @@ func TestTypeSystem_Scalar_SerializesOutputStrings(t *testing.T) {
 		{float64(-1.1), "-1.1"},
 		{true, "true"},
 		{false, "false"},
+		{testColor(2), "blue"},
+		{&testPointerStringer{"stringer"}, "pointer stringer"},
 	}
 
 	for _, test := range tests {
@@ -150,6 +169,192 @@ func TestTypeSystem_Scalar_SerializesOutputStrings(t *testing.T) {
 	}
 }
 
+func TestTypeSystem_Scalar_SerializesStringFieldsResolvedToStringers(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"color": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return testColor(1), nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ color }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"color": "green",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, expected: %v, got %v", expected, result)
+	}
+}
+
+func TestTypeSystem_Scalar_SerializesIDsWithTheSchemaHook(t *testing.T) {
+	var requestedID interface{}
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"friendIds": &graphql.Field{Type: graphql.NewList(graphql.ID)},
+			"name":      &graphql.Field{Type: graphql.String},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: userType,
+					Args: graphql.FieldConfigArgument{
+						"id": &graphql.ArgumentConfig{Type: graphql.ID},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						requestedID = p.Args["id"]
+						return map[string]interface{}{
+							"id":        1,
+							"friendIds": []interface{}{2, "3"},
+							"name":      "1",
+						}, nil
+					},
+				},
+			},
+		}),
+		SerializeID: func(value interface{}) interface{} {
+			return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("User:%v", value)))
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user(id: "1") { id friendIds name } }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"user": map[string]interface{}{
+				"id":        "VXNlcjox",
+				"friendIds": []interface{}{"VXNlcjoy", "VXNlcjoz"},
+				"name":      "1",
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, expected: %v, got %v", expected, result)
+	}
+	// input IDs are left to the resolvers to decode
+	if requestedID != "1" {
+		t.Fatalf("expected the id argument to be parsed as usual, got %v", requestedID)
+	}
+}
+
+func TestTypeSystem_Scalar_SerializesJSONPassingEncodedValuesThrough(t *testing.T) {
+	raw := json.RawMessage(`{"z":[1,2.5],"a":{"nested":true}}`)
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"raw": &graphql.Field{
+					Type: graphql.JSON,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return raw, nil
+					},
+				},
+				"echo": &graphql.Field{
+					Type: graphql.JSON,
+					Args: graphql.FieldConfigArgument{
+						"value": &graphql.ArgumentConfig{Type: graphql.JSON},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Args["value"], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ raw echo(value: {list: [1, 1.5, "two", false], enum: RED}) }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"raw": raw,
+			"echo": map[string]interface{}{
+				"list": []interface{}{1, 1.5, "two", false},
+				"enum": "RED",
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, expected: %v, got %v", expected, result)
+	}
+	b, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the raw value keeps its key order, as it is not encoded again
+	expectedJSON := `{"echo":{"enum":"RED","list":[1,1.5,"two",false]},"raw":{"z":[1,2.5],"a":{"nested":true}}}`
+	if string(b) != expectedJSON {
+		t.Fatalf("Unexpected JSON, expected: %v, got %v", expectedJSON, string(b))
+	}
+}
+
+func TestTypeSystem_Scalar_JSONLiteralsResolveNestedVariables(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"echo": &graphql.Field{
+					Type: graphql.JSON,
+					Args: graphql.FieldConfigArgument{
+						"value": &graphql.ArgumentConfig{Type: graphql.JSON},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Args["value"], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `query ($name: String, $tags: JSON, $missing: String) { echo(value: {name: $name, list: [1, $tags, $missing], big: 10000000000000000000}) }`,
+		VariableValues: map[string]interface{}{
+			"name": "Luke",
+			"tags": []interface{}{"a", "b"},
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"echo": map[string]interface{}{
+				"name": "Luke",
+				"list": []interface{}{1, []interface{}{"a", "b"}, nil},
+				"big":  1e19,
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, expected: %v, got %v", expected, result)
+	}
+}
+
 func TestTypeSystem_Scalar_SerializesOutputBoolean(t *testing.T) {
 	tests := []boolSerializationTest{
 		{"true", true},
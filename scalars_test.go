@@ -1,8 +1,11 @@
 package graphql
 
 import (
+	"encoding/json"
 	"math"
 	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
 )
 
 func TestCoerceInt(t *testing.T) {
@@ -236,6 +239,22 @@ func TestCoerceInt(t *testing.T) {
 			in:   "I'm not a number",
 			want: nil,
 		},
+		{
+			in:   json.Number("42"),
+			want: int(42),
+		},
+		{
+			in:   json.Number("1e3"),
+			want: int(1000),
+		},
+		{
+			in:   json.Number("4000000000"),
+			want: nil,
+		},
+		{
+			in:   (*json.Number)(nil),
+			want: nil,
+		},
 		{
 			in:   make(map[string]interface{}),
 			want: nil,
@@ -249,6 +268,102 @@ func TestCoerceInt(t *testing.T) {
 	}
 }
 
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want interface{}
+	}{
+		{
+			in:   math.MaxInt32,
+			want: int(math.MaxInt32),
+		},
+		{
+			in:   math.MinInt32,
+			want: int(math.MinInt32),
+		},
+		{
+			in:   int64(math.MaxInt32 + 1),
+			want: nil,
+		},
+		{
+			in:   int64(math.MinInt32 - 1),
+			want: nil,
+		},
+		{
+			in:   4000000000,
+			want: nil,
+		},
+		{
+			in:   float64(3),
+			want: int(3),
+		},
+		{
+			in:   float64(3.5),
+			want: nil,
+		},
+		{
+			in:   float32(-3.5),
+			want: nil,
+		},
+		{
+			in:   "3.5",
+			want: nil,
+		},
+		{
+			in:   json.Number("3.5"),
+			want: nil,
+		},
+		{
+			in:   math.NaN(),
+			want: nil,
+		},
+		{
+			in:   math.Inf(1),
+			want: nil,
+		},
+	}
+
+	for i, tt := range tests {
+		if got, want := parseInt(tt.in), tt.want; got != want {
+			t.Errorf("%d: in=%v, got=%v, want=%v", i, tt.in, got, want)
+		}
+	}
+}
+
+func TestIntParseLiteral(t *testing.T) {
+	tests := []struct {
+		in   ast.Value
+		want interface{}
+	}{
+		{
+			in:   &ast.IntValue{Value: "2147483647"},
+			want: int(2147483647),
+		},
+		{
+			in:   &ast.IntValue{Value: "-2147483648"},
+			want: int(-2147483648),
+		},
+		{
+			in:   &ast.IntValue{Value: "2147483648"},
+			want: nil,
+		},
+		{
+			in:   &ast.IntValue{Value: "-2147483649"},
+			want: nil,
+		},
+		{
+			in:   &ast.FloatValue{Value: "3.5"},
+			want: nil,
+		},
+	}
+
+	for i, tt := range tests {
+		if got, want := Int.ParseLiteral(tt.in), tt.want; got != want {
+			t.Errorf("%d: in=%v, got=%v, want=%v", i, tt.in, got, want)
+		}
+	}
+}
+
 func TestCoerceFloat(t *testing.T) {
 	tests := []struct {
 		in   interface{}
@@ -434,6 +549,14 @@ func TestCoerceFloat(t *testing.T) {
 			in:   "I'm not a number",
 			want: nil,
 		},
+		{
+			in:   json.Number("35.2"),
+			want: float64(35.2),
+		},
+		{
+			in:   json.Number("not a number"),
+			want: nil,
+		},
 		{
 			in:   make(map[string]interface{}),
 			want: nil,
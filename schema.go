@@ -1,5 +1,9 @@
 package graphql
 
+import (
+	"sort"
+)
+
 type SchemaConfig struct {
 	Query        *Object
 	Mutation     *Object
@@ -7,6 +11,28 @@ type SchemaConfig struct {
 	Types        []Type
 	Directives   []*Directive
 	Extensions   []Extension
+
+	// StrictArguments warns about non-null arguments that also have a
+	// default value. Such arguments are legal but not required, which is
+	// often a mistake. The warnings are reported by Schema.Warnings, and do
+	// not prevent creating the schema.
+	StrictArguments bool
+
+	// SerializeID, if set, serializes the values of ID fields in place of
+	// the ID scalar, e.g. to encode them as global IDs.
+	SerializeID SerializeFn
+
+	// TypeResolvers maps the names of interface and union types to the
+	// function resolving their runtime type, for types not defining their
+	// own ResolveType. This lets the resolution of all abstract types be
+	// registered in one place.
+	TypeResolvers map[string]ResolveTypeFn
+
+	// MaxOfTypeDepth, if positive, caps how many ofType fields nested in one
+	// another introspection resolves, returning null past that depth. This
+	// truncates the type references of deeply nested list and non-null
+	// wrappers to keep introspection results small.
+	MaxOfTypeDepth int
 }
 
 type TypeMap map[string]Type
@@ -41,6 +67,10 @@ type Schema struct {
 	implementations  map[string][]*Object
 	possibleTypeMap  map[string]map[string]bool
 	extensions       []Extension
+	serializeID      SerializeFn
+	typeResolvers    map[string]ResolveTypeFn
+	maxOfTypeDepth   int
+	warnings         []error
 }
 
 func NewSchema(config SchemaConfig) (Schema, error) {
@@ -97,6 +127,13 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 		initialTypes = append(initialTypes, ttype)
 	}
 
+	// types only used by the arguments of directives are part of the schema too
+	for _, dir := range schema.directives {
+		for _, arg := range dir.Args {
+			initialTypes = append(initialTypes, arg.Type)
+		}
+	}
+
 	for _, ttype := range initialTypes {
 		if ttype.Error() != nil {
 			return schema, ttype.Error()
@@ -125,6 +162,18 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 		}
 	}
 
+	schema.buildPossibleTypeMap()
+	schema.typeResolvers = config.TypeResolvers
+
+	// Enforce that the runtime type of abstract types can be resolved
+	for _, ttype := range schema.typeMap {
+		if ttype, ok := ttype.(Abstract); ok {
+			if err := assertAbstractTypeIsResolvable(&schema, ttype); err != nil {
+				return schema, err
+			}
+		}
+	}
+
 	// Enforce correct interface implementations
 	for _, ttype := range schema.typeMap {
 		if ttype, ok := ttype.(*Object); ok {
@@ -137,11 +186,18 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 		}
 	}
 
+	if config.StrictArguments {
+		schema.warnings = defaultedNonNullArgumentWarnings(&schema)
+	}
+
 	// Add extensions from config
 	if len(config.Extensions) != 0 {
 		schema.extensions = config.Extensions
 	}
 
+	schema.serializeID = config.SerializeID
+	schema.maxOfTypeDepth = config.MaxOfTypeDepth
+
 	return schema, nil
 }
 
@@ -149,10 +205,9 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 //Add Implementations at Runtime..
 func (gq *Schema) AddImplementation() error {
 
-	// Keep track of all implementations by interface name.
-	if gq.implementations == nil {
-		gq.implementations = map[string][]*Object{}
-	}
+	// Keep track of all implementations by interface name, starting over so
+	// that implementations already known are not added twice.
+	gq.implementations = map[string][]*Object{}
 	for _, ttype := range gq.typeMap {
 		if ttype, ok := ttype.(*Object); ok {
 			for _, iface := range ttype.Interfaces() {
@@ -178,6 +233,7 @@ func (gq *Schema) AddImplementation() error {
 		}
 	}
 
+	gq.buildPossibleTypeMap()
 	return nil
 }
 
@@ -229,6 +285,8 @@ func (gq *Schema) Type(name string) Type {
 	return gq.TypeMap()[name]
 }
 
+// PossibleTypes returns the object types implementing the interface, or the
+// members of the union.
 func (gq *Schema) PossibleTypes(abstractType Abstract) []*Object {
 	switch abstractType := abstractType.(type) {
 	case *Union:
@@ -240,26 +298,39 @@ func (gq *Schema) PossibleTypes(abstractType Abstract) []*Object {
 	}
 	return []*Object{}
 }
+
+// IsPossibleType returns true if the object type implements the interface, or
+// is a member of the union. It only reads the possible types computed when
+// the schema was built, so it is safe to call concurrently.
 func (gq *Schema) IsPossibleType(abstractType Abstract, possibleType *Object) bool {
-	possibleTypeMap := gq.possibleTypeMap
-	if possibleTypeMap == nil {
-		possibleTypeMap = map[string]map[string]bool{}
+	if typeMap, ok := gq.possibleTypeMap[abstractType.Name()]; ok {
+		return typeMap[possibleType.Name()]
+	}
+	// the abstract type is not part of the schema
+	for _, ttype := range gq.PossibleTypes(abstractType) {
+		if ttype.Name() == possibleType.Name() {
+			return true
+		}
 	}
+	return false
+}
 
-	if typeMap, ok := possibleTypeMap[abstractType.Name()]; !ok {
-		typeMap = map[string]bool{}
+// buildPossibleTypeMap computes the names of the possible types of each
+// abstract type of the schema, for IsPossibleType.
+func (gq *Schema) buildPossibleTypeMap() {
+	possibleTypeMap := map[string]map[string]bool{}
+	for _, ttype := range gq.typeMap {
+		abstractType, ok := ttype.(Abstract)
+		if !ok {
+			continue
+		}
+		typeMap := map[string]bool{}
 		for _, possibleType := range gq.PossibleTypes(abstractType) {
 			typeMap[possibleType.Name()] = true
 		}
 		possibleTypeMap[abstractType.Name()] = typeMap
 	}
-
 	gq.possibleTypeMap = possibleTypeMap
-	if typeMap, ok := possibleTypeMap[abstractType.Name()]; ok {
-		isPossible, _ := typeMap[possibleType.Name()]
-		return isPossible
-	}
-	return false
 }
 
 // AddExtensions can be used to add additional extensions to the schema
@@ -378,12 +449,127 @@ func typeMapReducer(schema *Schema, typeMap TypeMap, objectType Type) (TypeMap,
 	return typeMap, nil
 }
 
+// assertAbstractTypeIsResolvable returns an error if the abstract type has no
+// function resolving its runtime type, and one of its possible types does not
+// define IsTypeOf either.
+func assertAbstractTypeIsResolvable(schema *Schema, abstractType Abstract) error {
+	if schema.resolveTypeFn(abstractType) != nil {
+		return nil
+	}
+	for _, possibleType := range schema.PossibleTypes(abstractType) {
+		if possibleType.IsTypeOf != nil {
+			continue
+		}
+		if _, ok := abstractType.(*Union); ok {
+			return invariantf(false,
+				`Union Type %v does not provide a "resolveType" function `+
+					`and possible Type %v does not provide a "isTypeOf" `+
+					`function. There is no way to resolve this possible type `+
+					`during execution.`, abstractType, possibleType,
+			)
+		}
+		return invariantf(false,
+			`Interface Type %v does not provide a "resolveType" function `+
+				`and implementing Type %v does not provide a "isTypeOf" `+
+				`function. There is no way to resolve this implementing type `+
+				`during execution.`, abstractType, possibleType,
+		)
+	}
+	return nil
+}
+
+// resolveTypeFn returns the function resolving the runtime type of the
+// abstract type, either its own or the one registered in the schema.
+func (gq *Schema) resolveTypeFn(abstractType Abstract) ResolveTypeFn {
+	switch abstractType := abstractType.(type) {
+	case *Union:
+		if abstractType.ResolveType != nil {
+			return abstractType.ResolveType
+		}
+	case *Interface:
+		if abstractType.ResolveType != nil {
+			return abstractType.ResolveType
+		}
+	}
+	return gq.typeResolvers[abstractType.Name()]
+}
+
+// Warnings returns the problems found while creating the schema that, unlike
+// errors, did not prevent it, e.g. those reported by
+// SchemaConfig.StrictArguments.
+func (gq *Schema) Warnings() []error {
+	return gq.warnings
+}
+
+// defaultedNonNullArgumentWarnings returns a warning for each non-null field
+// or directive argument that also has a default value.
+func defaultedNonNullArgumentWarnings(schema *Schema) []error {
+	warnings := []error{}
+	typeNames := []string{}
+	for name := range schema.TypeMap() {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		var fields FieldDefinitionMap
+		switch ttype := schema.Type(typeName).(type) {
+		case *Object:
+			fields = ttype.Fields()
+		case *Interface:
+			fields = ttype.Fields()
+		default:
+			continue
+		}
+		fieldNames := []string{}
+		for name := range fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			for _, arg := range fields[fieldName].Args {
+				err := invariantf(
+					!isDefaultedNonNull(arg),
+					`%v.%v(%v:) is of non-null type "%v" but has a default value, so it is not required.`,
+					typeName, fieldName, arg.Name(), arg.Type,
+				)
+				if err != nil {
+					warnings = append(warnings, err)
+				}
+			}
+		}
+	}
+	for _, directive := range schema.Directives() {
+		for _, arg := range directive.Args {
+			err := invariantf(
+				!isDefaultedNonNull(arg),
+				`@%v(%v:) is of non-null type "%v" but has a default value, so it is not required.`,
+				directive.Name, arg.Name(), arg.Type,
+			)
+			if err != nil {
+				warnings = append(warnings, err)
+			}
+		}
+	}
+	return warnings
+}
+
+func isDefaultedNonNull(arg *Argument) bool {
+	_, ok := arg.Type.(*NonNull)
+	return ok && arg.DefaultValue != nil
+}
+
 func assertObjectImplementsInterface(schema *Schema, object *Object, iface *Interface) error {
 	objectFieldMap := object.Fields()
 	ifaceFieldMap := iface.Fields()
 
-	// Assert each interface field is implemented.
+	// Assert each interface field is implemented, in order of their names to
+	// always report the same problem first.
+	fieldNames := make([]string, 0, len(ifaceFieldMap))
 	for fieldName := range ifaceFieldMap {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
 		objectField := objectFieldMap[fieldName]
 		ifaceField := ifaceFieldMap[fieldName]
 
@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/printer"
+)
+
+// PrintSchema prints the schema in the GraphQL schema language (SDL).
+// Built-in scalars, introspection types and the specified directives are
+// omitted; use PrintIntrospectionSchema to print those.
+func PrintSchema(schema Schema) string {
+	printed := printFilteredSchema(schema, func(directive *Directive) bool {
+		return !isSpecifiedDirective(directive)
+	}, func(ttype Type) bool {
+		return !isIntrospectionType(ttype) && !isBuiltInScalar(ttype)
+	})
+	if def := printSchemaDefinition(schema); def != "" {
+		printed = def + "\n\n" + printed
+	}
+	return printed
+}
+
+// PrintIntrospectionSchema prints the specified directives and the
+// introspection types (those prefixed with "__") of the schema.
+func PrintIntrospectionSchema(schema Schema) string {
+	return printFilteredSchema(schema, isSpecifiedDirective, isIntrospectionType)
+}
+
+func isSpecifiedDirective(directive *Directive) bool {
+	for _, specified := range SpecifiedDirectives {
+		if specified.Name == directive.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func isIntrospectionType(ttype Type) bool {
+	return strings.HasPrefix(ttype.Name(), "__")
+}
+
+func isBuiltInScalar(ttype Type) bool {
+	switch ttype.Name() {
+	case String.Name(), Boolean.Name(), Int.Name(), Float.Name(), ID.Name():
+		_, ok := ttype.(*Scalar)
+		return ok
+	}
+	return false
+}
+
+func printFilteredSchema(schema Schema, directiveFilter func(*Directive) bool, typeFilter func(Type) bool) string {
+	parts := []string{}
+	for _, directive := range schema.Directives() {
+		if directiveFilter(directive) {
+			parts = append(parts, printDirective(directive))
+		}
+	}
+
+	typeNames := []string{}
+	for name, ttype := range schema.TypeMap() {
+		if typeFilter(ttype) {
+			typeNames = append(typeNames, name)
+		}
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		parts = append(parts, printType(schema.Type(name)))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// printSchemaDefinition prints the schema definition, unless the root types
+// all use the conventional names, in which case it may be omitted.
+func printSchemaDefinition(schema Schema) string {
+	query := schema.QueryType()
+	mutation := schema.MutationType()
+	subscription := schema.SubscriptionType()
+	if query != nil && query.Name() == "Query" &&
+		(mutation == nil || mutation.Name() == "Mutation") &&
+		(subscription == nil || subscription.Name() == "Subscription") {
+		return ""
+	}
+
+	operationTypes := []string{}
+	if query != nil {
+		operationTypes = append(operationTypes, fmt.Sprintf("  query: %v", query.Name()))
+	}
+	if mutation != nil {
+		operationTypes = append(operationTypes, fmt.Sprintf("  mutation: %v", mutation.Name()))
+	}
+	if subscription != nil {
+		operationTypes = append(operationTypes, fmt.Sprintf("  subscription: %v", subscription.Name()))
+	}
+	return fmt.Sprintf("schema {\n%v\n}", strings.Join(operationTypes, "\n"))
+}
+
+func printType(ttype Type) string {
+	switch ttype := ttype.(type) {
+	case *Scalar:
+		return printDescription(ttype.Description(), "") + fmt.Sprintf("scalar %v", ttype.Name())
+	case *Object:
+		implements := ""
+		if interfaces := ttype.Interfaces(); len(interfaces) > 0 {
+			names := []string{}
+			for _, iface := range interfaces {
+				names = append(names, iface.Name())
+			}
+			implements = " implements " + strings.Join(names, " & ")
+		}
+		return printDescription(ttype.Description(), "") +
+			fmt.Sprintf("type %v%v {\n%v\n}", ttype.Name(), implements, printFields(ttype.Fields()))
+	case *Interface:
+		return printDescription(ttype.Description(), "") +
+			fmt.Sprintf("interface %v {\n%v\n}", ttype.Name(), printFields(ttype.Fields()))
+	case *Union:
+		names := []string{}
+		for _, object := range ttype.Types() {
+			names = append(names, object.Name())
+		}
+		return printDescription(ttype.Description(), "") +
+			fmt.Sprintf("union %v = %v", ttype.Name(), strings.Join(names, " | "))
+	case *Enum:
+		values := append([]*EnumValueDefinition{}, ttype.Values()...)
+		sort.Slice(values, func(i, j int) bool {
+			return values[i].Name < values[j].Name
+		})
+		lines := []string{}
+		for _, value := range values {
+			lines = append(lines, printDescription(value.Description, "  ")+
+				"  "+value.Name+printDeprecated(value.DeprecationReason))
+		}
+		return printDescription(ttype.Description(), "") +
+			fmt.Sprintf("enum %v {\n%v\n}", ttype.Name(), strings.Join(lines, "\n"))
+	case *InputObject:
+		fields := ttype.Fields()
+		names := []string{}
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		lines := []string{}
+		for _, name := range names {
+			field := fields[name]
+			lines = append(lines, printDescription(field.Description(), "  ")+
+				"  "+printInputValue(field.Name(), field.Type, field.DefaultValue))
+		}
+		oneOf := ""
+		if ttype.IsOneOf() {
+			oneOf = " @oneOf"
+		}
+		return printDescription(ttype.Description(), "") +
+			fmt.Sprintf("input %v%v {\n%v\n}", ttype.Name(), oneOf, strings.Join(lines, "\n"))
+	}
+	return ""
+}
+
+func printFields(fields FieldDefinitionMap) string {
+	names := []string{}
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := []string{}
+	for _, name := range names {
+		field := fields[name]
+		lines = append(lines, printDescription(field.Description, "  ")+
+			fmt.Sprintf("  %v%v: %v%v", name, printArgs(field.Args), field.Type, printDeprecated(field.DeprecationReason)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func printArgs(args []*Argument) string {
+	if len(args) == 0 {
+		return ""
+	}
+	sorted := append([]*Argument{}, args...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+	printed := []string{}
+	for _, arg := range sorted {
+		printed = append(printed, printInputValue(arg.Name(), arg.Type, arg.DefaultValue))
+	}
+	return "(" + strings.Join(printed, ", ") + ")"
+}
+
+func printInputValue(name string, ttype Input, defaultValue interface{}) string {
+	str := fmt.Sprintf("%v: %v", name, ttype)
+	if valueAST := astFromValue(defaultValue, ttype); valueAST != nil {
+		str += fmt.Sprintf(" = %v", printer.Print(valueAST))
+	}
+	return str
+}
+
+func printDeprecated(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	if reason == DefaultDeprecationReason {
+		return " @deprecated"
+	}
+	reasonAST := ast.NewStringValue(&ast.StringValue{Value: reason})
+	return fmt.Sprintf(" @deprecated(reason: %v)", printer.Print(reasonAST))
+}
+
+func printDirective(directive *Directive) string {
+	return printDescription(directive.Description, "") +
+		fmt.Sprintf("directive @%v%v on %v", directive.Name, printArgs(directive.Args), strings.Join(directive.Locations, " | "))
+}
+
+// printDescription prints a description as comment lines, each prefixed with
+// the given indentation.
+func printDescription(description string, indentation string) string {
+	if description == "" {
+		return ""
+	}
+	lines := []string{}
+	for _, line := range strings.Split(description, "\n") {
+		if line == "" {
+			lines = append(lines, indentation+"#")
+			continue
+		}
+		lines = append(lines, indentation+"# "+line)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
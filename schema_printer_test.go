@@ -0,0 +1,190 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestPrintSchema_PrintsTypesInSDL(t *testing.T) {
+	namedType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        "Named",
+		Description: "Something with a name",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return nil
+		},
+	})
+	colorType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Color",
+		Values: graphql.EnumValueConfigMap{
+			"RED":   &graphql.EnumValueConfig{Value: "RED"},
+			"GREEN": &graphql.EnumValueConfig{Value: "GREEN", Description: "The color of grass"},
+			"BLUE":  &graphql.EnumValueConfig{Value: "BLUE", DeprecationReason: graphql.DefaultDeprecationReason},
+		},
+	})
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"color": &graphql.InputObjectFieldConfig{Type: colorType, DefaultValue: "RED"},
+			"limit": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int), DefaultValue: 10},
+		},
+	})
+	petType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Pet",
+		Description: "A pet\nwith two lines",
+		Interfaces:  []*graphql.Interface{namedType},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+			"nickname": &graphql.Field{
+				Type:              graphql.String,
+				DeprecationReason: "Use `name`.",
+			},
+			"colors": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(colorType)),
+				Description: "Colors of the coat",
+			},
+		},
+	})
+	searchType := graphql.NewUnion(graphql.UnionConfig{
+		Name:  "Search",
+		Types: []*graphql.Object{petType},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return petType
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Root",
+			Fields: graphql.Fields{
+				"pets": &graphql.Field{
+					Type: graphql.NewList(petType),
+					Args: graphql.FieldConfigArgument{
+						"filter": &graphql.ArgumentConfig{Type: filterType},
+						"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 5},
+					},
+				},
+				"search": &graphql.Field{Type: searchType},
+			},
+		}),
+		Directives: append([]*graphql.Directive{
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name:      "cached",
+				Locations: []string{graphql.DirectiveLocationField, graphql.DirectiveLocationFragmentSpread},
+				Args: graphql.FieldConfigArgument{
+					"ttl": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+			}),
+		}, graphql.SpecifiedDirectives...),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `schema {
+  query: Root
+}
+
+directive @cached(ttl: Int) on FIELD | FRAGMENT_SPREAD
+
+enum Color {
+  BLUE @deprecated
+  # The color of grass
+  GREEN
+  RED
+}
+
+input Filter {
+  color: Color = RED
+  limit: Int! = 10
+}
+
+# Something with a name
+interface Named {
+  name: String
+}
+
+# A pet
+# with two lines
+type Pet implements Named {
+  # Colors of the coat
+  colors: [Color!]
+  name: String
+  nickname: String @deprecated(reason: "Use ` + "`name`" + `.")
+}
+
+type Root {
+  pets(filter: Filter, first: Int = 5): [Pet]
+  search: Search
+}
+
+union Search = Pet
+`
+	if printed := graphql.PrintSchema(schema); printed != expected {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
+
+func TestPrintSchema_OmitsConventionalSchemaDefinition(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		type Query {
+			hello(name: String = "world"): String
+		}
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `type Query {
+  hello(name: String = "world"): String
+}
+`
+	if printed := graphql.PrintSchema(schema); printed != expected {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
+
+func TestPrintSchema_RoundTripsThroughBuildSchema(t *testing.T) {
+	sdl := `type Character {
+  friends: [Character]
+  name: String!
+}
+
+type Query {
+  hero(episode: Int = 4): Character
+}
+`
+	schema, err := graphql.BuildSchema(sdl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(sdl, printed))
+	}
+}
+
+func TestPrintIntrospectionSchema_PrintsOnlyIntrospectionTypes(t *testing.T) {
+	schema, err := graphql.BuildSchema(`type Query { name: String }`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	printed := graphql.PrintIntrospectionSchema(schema)
+	for _, expected := range []string{
+		"directive @include(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT",
+		"directive @skip(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT",
+		"type __Schema {",
+		"type __Type {",
+		"enum __TypeKind {",
+		"  fields(includeDeprecated: Boolean = false): [__Field!]",
+	} {
+		if !strings.Contains(printed, expected) {
+			t.Fatalf("expected introspection schema to contain %q, got:\n%v", expected, printed)
+		}
+	}
+	if strings.Contains(printed, "type Query") {
+		t.Fatalf("expected introspection schema to omit user types, got:\n%v", printed)
+	}
+}
@@ -54,12 +54,18 @@ func Subscribe(p Params) chan *Result {
 
 	}
 	return ExecuteSubscription(ExecuteParams{
-		Schema:        p.Schema,
-		Root:          p.RootObject,
-		AST:           AST,
-		OperationName: p.OperationName,
-		Args:          p.VariableValues,
-		Context:       p.Context,
+		Schema:           p.Schema,
+		Root:             p.rootValue(),
+		AST:              AST,
+		OperationName:    p.OperationName,
+		Args:             p.VariableValues,
+		Context:          p.Context,
+		MaxRootFields:    p.MaxRootFields,
+		MaxFragmentDepth: p.MaxFragmentDepth,
+		MaxNodes:         p.MaxNodes,
+		BatchThunks:      p.BatchThunks,
+		MaxConcurrency:   p.MaxConcurrency,
+		NonFiniteFloats:  p.NonFiniteFloats,
 	})
 }
 
@@ -80,12 +86,18 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 
 	var mapSourceToResponse = func(payload interface{}) *Result {
 		return Execute(ExecuteParams{
-			Schema:        p.Schema,
-			Root:          payload,
-			AST:           p.AST,
-			OperationName: p.OperationName,
-			Args:          p.Args,
-			Context:       p.Context,
+			Schema:           p.Schema,
+			Root:             payload,
+			AST:              p.AST,
+			OperationName:    p.OperationName,
+			Args:             p.Args,
+			Context:          p.Context,
+			MaxRootFields:    p.MaxRootFields,
+			MaxFragmentDepth: p.MaxFragmentDepth,
+			MaxNodes:         p.MaxNodes,
+			BatchThunks:      p.BatchThunks,
+			MaxConcurrency:   p.MaxConcurrency,
+			NonFiniteFloats:  p.NonFiniteFloats,
 		})
 	}
 	var resultChannel = make(chan *Result)
@@ -105,12 +117,18 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 		}()
 
 		exeContext, err := buildExecutionContext(buildExecutionCtxParams{
-			Schema:        p.Schema,
-			Root:          p.Root,
-			AST:           p.AST,
-			OperationName: p.OperationName,
-			Args:          p.Args,
-			Context:       p.Context,
+			Schema:           p.Schema,
+			Root:             p.Root,
+			AST:              p.AST,
+			OperationName:    p.OperationName,
+			Args:             p.Args,
+			Context:          p.Context,
+			MaxRootFields:    p.MaxRootFields,
+			MaxFragmentDepth: p.MaxFragmentDepth,
+			MaxNodes:         p.MaxNodes,
+			BatchThunks:      p.BatchThunks,
+			MaxConcurrency:   p.MaxConcurrency,
+			NonFiniteFloats:  p.NonFiniteFloats,
 		})
 
 		if err != nil {
@@ -135,6 +153,12 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 			RuntimeType:  operationType,
 			SelectionSet: exeContext.Operation.GetSelectionSet(),
 		})
+		if err := checkMaxRootFields(exeContext, fields); err != nil {
+			resultChannel <- &Result{
+				Errors: gqlerrors.FormatErrors(err),
+			}
+			return
+		}
 
 		responseNames := []string{}
 		for name := range fields {
@@ -167,6 +191,14 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 		}
 
 		args := getArgumentValues(fieldDef.Args, fieldNode.Arguments, exeContext.VariableValues)
+		if fieldDef.CoerceArgs != nil {
+			if args, err = fieldDef.CoerceArgs(args); err != nil {
+				resultChannel <- &Result{
+					Errors: gqlerrors.FormatErrors(err),
+				}
+				return
+			}
+		}
 		info := ResolveInfo{
 			FieldName:      fieldName,
 			FieldASTs:      fieldNodes,
@@ -181,7 +213,7 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 		}
 
 		fieldResult, err := resolveFn(ResolveParams{
-			Source:  p.Root,
+			Source:  exeContext.Root,
 			Args:    args,
 			Info:    info,
 			Context: p.Context,
@@ -214,7 +246,12 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 					if !more {
 						return
 					}
-					resultChannel <- mapSourceToResponse(res)
+					// stop waiting for a reader once the subscription is cancelled
+					select {
+					case <-p.Context.Done():
+						return
+					case resultChannel <- mapSourceToResponse(res):
+					}
 				}
 			}
 		default:
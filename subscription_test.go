@@ -1,11 +1,16 @@
 package graphql_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -207,6 +212,37 @@ func TestSchemaSubscribe(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "resolver_error_does_not_end_the_stream",
+			Schema: makeSubscriptionSchema(t, graphql.ObjectConfig{
+				Name: "Subscription",
+				Fields: graphql.Fields{
+					"sub_with_resolver": &graphql.Field{
+						Type: graphql.String,
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							if p.Source == "b" {
+								return nil, errors.New("got a resolve error")
+							}
+							return p.Source, nil
+						},
+						Subscribe: makeSubscribeToStringFunction([]string{"a", "b", "c"}),
+					},
+				},
+			}),
+			Query: `
+				subscription {
+					sub_with_resolver
+				}
+			`,
+			ExpectedResults: []testutil.TestResponse{
+				{Data: `{ "sub_with_resolver": "a" }`},
+				{
+					Data:   `{ "sub_with_resolver": null }`,
+					Errors: []string{"got a resolve error"},
+				},
+				{Data: `{ "sub_with_resolver": "c" }`},
+			},
+		},
 		{
 			Name: "schema_without_subscribe_errors",
 			Schema: makeSubscriptionSchema(t, graphql.ObjectConfig{
@@ -231,6 +267,177 @@ func TestSchemaSubscribe(t *testing.T) {
 	})
 }
 
+func TestSchemaSubscribe_ClosesTheChannelWhenTheContextIsCancelled(t *testing.T) {
+	schema := makeSubscriptionSchema(t, graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"ticks": &graphql.Field{
+				Type: graphql.Int,
+				Subscribe: func(p graphql.ResolveParams) (interface{}, error) {
+					c := make(chan interface{})
+					go func() {
+						defer close(c)
+						for i := 0; ; i++ {
+							select {
+							case <-p.Context.Done():
+								return
+							case c <- map[string]interface{}{"ticks": i}:
+							}
+						}
+					}()
+					return c, nil
+				},
+			},
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	c := graphql.Subscribe(graphql.Params{
+		Context:       ctx,
+		RequestString: `subscription { ticks }`,
+		Schema:        schema,
+	})
+	first := <-c
+	if !reflect.DeepEqual(first.Data, map[string]interface{}{"ticks": 0}) {
+		t.Fatalf("unexpected first result: %v", first.Data)
+	}
+	cancel()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, more := <-c:
+			if !more {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("expected the result channel to be closed after cancelling the context")
+		}
+	}
+}
+
+func TestSchemaSubscribe_RejectsOperationsSelectingMoreThanMaxRootFields(t *testing.T) {
+	subscribed := false
+	subscribe := func(p graphql.ResolveParams) (interface{}, error) {
+		subscribed = true
+		return makeSubscribeToStringFunction([]string{"a"})(p)
+	}
+	schema := makeSubscriptionSchema(t, graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"a": &graphql.Field{Type: graphql.String, Subscribe: subscribe},
+			"b": &graphql.Field{Type: graphql.String, Subscribe: subscribe},
+		},
+	})
+	c := graphql.Subscribe(graphql.Params{
+		RequestString: `subscription { a b }`,
+		Schema:        schema,
+		MaxRootFields: 1,
+	})
+
+	results := []*graphql.Result{}
+	for result := range c {
+		results = append(results, result)
+	}
+	if len(results) != 1 || results[0].Data != nil {
+		t.Fatalf("expected a single result without data, got %v", results)
+	}
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message:   "Operation selects 2 root fields, which exceeds the maximum of 1.",
+			Locations: []location.SourceLocation{{Line: 1, Column: 1}},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, results[0].Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, results[0].Errors))
+	}
+	if subscribed {
+		t.Fatalf("expected the subscription not to be started")
+	}
+}
+
+func TestSchemaSubscribe_RejectsFragmentsNestedDeeperThanMaxFragmentDepth(t *testing.T) {
+	subscribed := false
+	schema := makeSubscriptionSchema(t, graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"a": &graphql.Field{
+				Type: graphql.String,
+				Subscribe: func(p graphql.ResolveParams) (interface{}, error) {
+					subscribed = true
+					return makeSubscribeToStringFunction([]string{"a"})(p)
+				},
+			},
+		},
+	})
+	c := graphql.Subscribe(graphql.Params{
+		RequestString: `
+		  subscription { ...A }
+		  fragment A on Subscription { ...B }
+		  fragment B on Subscription { a }
+		`,
+		Schema:           schema,
+		MaxFragmentDepth: 1,
+	})
+
+	results := []*graphql.Result{}
+	for result := range c {
+		results = append(results, result)
+	}
+	if len(results) != 1 || results[0].Data != nil {
+		t.Fatalf("expected a single result without data, got %v", results)
+	}
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message:   "Fragments are nested deeper than the maximum depth of 1.",
+			Locations: []location.SourceLocation{},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, results[0].Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, results[0].Errors))
+	}
+	if subscribed {
+		t.Fatalf("expected the subscription not to be started")
+	}
+}
+
+func TestSchemaSubscribe_ReportsResolverPanicsEvenIfRecoverIsDisabled(t *testing.T) {
+	schema := makeSubscriptionSchema(t, graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"panics": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					panic("resolver panicked")
+				},
+				Subscribe: makeSubscribeToStringFunction([]string{"a"}),
+			},
+		},
+	})
+	c := graphql.Subscribe(graphql.Params{
+		RequestString:  `subscription { panics }`,
+		Schema:         schema,
+		DisableRecover: true,
+	})
+
+	results := []*graphql.Result{}
+	for result := range c {
+		results = append(results, result)
+	}
+	if len(results) != 1 || !reflect.DeepEqual(results[0].Data, map[string]interface{}{"panics": nil}) {
+		t.Fatalf("expected a single result with a null field, got %v", results)
+	}
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message:   "resolver panicked",
+			Locations: []location.SourceLocation{{Line: 1, Column: 16}},
+			Path:      []interface{}{"panics"},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, results[0].Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, results[0].Errors))
+	}
+}
+
 func makeSubscribeToStringFunction(elements []string) func(p graphql.ResolveParams) (interface{}, error) {
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		c := make(chan interface{})
@@ -267,6 +474,49 @@ func makeSubscribeToMapFunction(elements []map[string]interface{}) func(p graphq
 	}
 }
 
+func TestSchemaSubscribe_ExecuteResolvesASingleValue(t *testing.T) {
+	schema := makeSubscriptionSchema(t, graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"newMessage": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return "hello", nil
+				},
+			},
+		},
+	})
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `subscription OnMessage { newMessage }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"newMessage": "hello",
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ __schema { subscriptionType { name } } }`,
+	})
+	expected = &graphql.Result{
+		Data: map[string]interface{}{
+			"__schema": map[string]interface{}{
+				"subscriptionType": map[string]interface{}{
+					"name": "Subscription",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func makeSubscriptionSchema(t *testing.T, c graphql.ObjectConfig) graphql.Schema {
 	schema, err := graphql.NewSchema(graphql.SchemaConfig{
 		Query:        dummyQuery,
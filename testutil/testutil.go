@@ -500,5 +500,6 @@ func EqualResults(expected, result *graphql.Result) bool {
 	if !reflect.DeepEqual(expected.Data, result.Data) {
 		return false
 	}
-	return EqualFormattedErrors(expected.Errors, result.Errors)
+	return EqualFormattedErrors(expected.Errors, result.Errors) &&
+		EqualFormattedErrors(expected.Warnings, result.Warnings)
 }
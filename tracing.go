@@ -0,0 +1,159 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+type tracingContextKey struct{}
+
+// operationNameContextKey holds the name of the executed operation, set by
+// Execute for the extensions.
+type operationNameContextKey struct{}
+
+// Tracing is an Extension recording how long a request took to execute, and
+// how long the resolver of each field took. The timings are reported under
+// the "tracing" key of the result extensions.
+//
+// Requests are timed from the start of graphql.Do.
+type Tracing struct {
+	// Now returns the current time. It defaults to time.Now, and may be
+	// replaced, e.g. by a fake clock to make durations deterministic in tests.
+	Now func() time.Time
+}
+
+// TracingResult is the result the Tracing extension adds to a Result.
+type TracingResult struct {
+	// OperationName is the name of the executed operation, empty if it is
+	// anonymous.
+	OperationName string             `json:"operationName,omitempty"`
+	StartTime     time.Time          `json:"startTime"`
+	EndTime       time.Time          `json:"endTime"`
+	Duration      time.Duration      `json:"duration"`
+	Resolvers     []*TracingResolver `json:"resolvers"`
+}
+
+// TracingResolver is the timing of a single field resolver.
+type TracingResolver struct {
+	Path        []interface{} `json:"path"`
+	ParentType  string        `json:"parentType"`
+	FieldName   string        `json:"fieldName"`
+	ReturnType  string        `json:"returnType"`
+	StartOffset time.Duration `json:"startOffset"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// tracingRequest holds the timings recorded for a single request.
+type tracingRequest struct {
+	mu     sync.Mutex
+	result TracingResult
+}
+
+func (t *Tracing) now() time.Time {
+	if t.Now != nil {
+		return t.Now()
+	}
+	return time.Now()
+}
+
+func (t *Tracing) request(ctx context.Context) *tracingRequest {
+	if ctx == nil {
+		return nil
+	}
+	request, _ := ctx.Value(tracingContextKey{}).(*tracingRequest)
+	return request
+}
+
+// Init starts timing the request.
+func (t *Tracing) Init(ctx context.Context, p *Params) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	request := &tracingRequest{}
+	request.result.OperationName = p.OperationName
+	request.result.StartTime = t.now()
+	request.result.Resolvers = []*TracingResolver{}
+	return context.WithValue(ctx, tracingContextKey{}, request)
+}
+
+// Name returns the key the timings are reported under.
+func (t *Tracing) Name() string {
+	return "tracing"
+}
+
+// ParseDidStart is a no-op.
+func (t *Tracing) ParseDidStart(ctx context.Context) (context.Context, ParseFinishFunc) {
+	return ctx, func(err error) {}
+}
+
+// ValidationDidStart is a no-op.
+func (t *Tracing) ValidationDidStart(ctx context.Context) (context.Context, ValidationFinishFunc) {
+	return ctx, func(errs []gqlerrors.FormattedError) {}
+}
+
+// ExecutionDidStart records the name of the executed operation, and the end
+// of the request once it is executed.
+func (t *Tracing) ExecutionDidStart(ctx context.Context) (context.Context, ExecutionFinishFunc) {
+	// the operation is only named by the request when the document has
+	// several of them
+	if request := t.request(ctx); request != nil {
+		if name, ok := ctx.Value(operationNameContextKey{}).(string); ok {
+			request.mu.Lock()
+			request.result.OperationName = name
+			request.mu.Unlock()
+		}
+	}
+	return ctx, func(result *Result) {
+		request := t.request(ctx)
+		if request == nil {
+			return
+		}
+		request.mu.Lock()
+		defer request.mu.Unlock()
+		request.result.EndTime = t.now()
+		request.result.Duration = request.result.EndTime.Sub(request.result.StartTime)
+	}
+}
+
+// ResolveFieldDidStart records the duration of the resolver of a field.
+func (t *Tracing) ResolveFieldDidStart(ctx context.Context, info *ResolveInfo) (context.Context, ResolveFieldFinishFunc) {
+	request := t.request(ctx)
+	if request == nil {
+		return ctx, func(interface{}, error) {}
+	}
+	start := t.now()
+	resolver := &TracingResolver{
+		Path:       info.Path.AsArray(),
+		ParentType: info.ParentType.Name(),
+		FieldName:  info.FieldName,
+		ReturnType: info.ReturnType.String(),
+	}
+	return ctx, func(interface{}, error) {
+		end := t.now()
+		request.mu.Lock()
+		defer request.mu.Unlock()
+		resolver.StartOffset = start.Sub(request.result.StartTime)
+		resolver.Duration = end.Sub(start)
+		request.result.Resolvers = append(request.result.Resolvers, resolver)
+	}
+}
+
+// HasResult returns true, the timings are always reported.
+func (t *Tracing) HasResult() bool {
+	return true
+}
+
+// GetResult returns the TracingResult of the request.
+func (t *Tracing) GetResult(ctx context.Context) interface{} {
+	request := t.request(ctx)
+	if request == nil {
+		return nil
+	}
+	request.mu.Lock()
+	defer request.mu.Unlock()
+	result := request.result
+	return &result
+}
@@ -0,0 +1,178 @@
+package graphql_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+// fakeClock is a clock that only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestTracing_ReportsFieldDurationsMeasuredWithTheInjectedClock(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	sleep := func(d time.Duration) graphql.FieldResolveFn {
+		return func(p graphql.ResolveParams) (interface{}, error) {
+			clock.Advance(d)
+			return p.Info.FieldName, nil
+		}
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"fast": &graphql.Field{
+					Type:    graphql.String,
+					Resolve: sleep(time.Millisecond),
+				},
+				"slow": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.String),
+					Resolve: sleep(5 * time.Millisecond),
+				},
+			},
+		}),
+		Extensions: []graphql.Extension{&graphql.Tracing{Now: clock.Now}},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ fast slow }`,
+	})
+	if len(result.Errors) != 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	tracing, ok := result.Extensions["tracing"].(*graphql.TracingResult)
+	if !ok {
+		t.Fatalf("expected a tracing result, got %#v", result.Extensions["tracing"])
+	}
+	if tracing.Duration != 6*time.Millisecond {
+		t.Fatalf("expected the request to take 6ms, got %v", tracing.Duration)
+	}
+	if !tracing.EndTime.Equal(tracing.StartTime.Add(tracing.Duration)) {
+		t.Fatalf("expected end time %v to be the start time %v plus the duration", tracing.EndTime, tracing.StartTime)
+	}
+
+	// fields are resolved in no particular order, so only durations are compared.
+	sort.Slice(tracing.Resolvers, func(i, j int) bool {
+		return tracing.Resolvers[i].FieldName < tracing.Resolvers[j].FieldName
+	})
+	for _, resolver := range tracing.Resolvers {
+		resolver.StartOffset = 0
+	}
+	expected := []*graphql.TracingResolver{
+		{
+			Path:       []interface{}{"fast"},
+			ParentType: "Query",
+			FieldName:  "fast",
+			ReturnType: "String",
+			Duration:   time.Millisecond,
+		},
+		{
+			Path:       []interface{}{"slow"},
+			ParentType: "Query",
+			FieldName:  "slow",
+			ReturnType: "String!",
+			Duration:   5 * time.Millisecond,
+		},
+	}
+	if !reflect.DeepEqual(expected, tracing.Resolvers) {
+		t.Fatalf("Unexpected resolvers, Diff: %v", testutil.Diff(expected, tracing.Resolvers))
+	}
+}
+
+func TestTracing_ReportsTheNameOfTheExecutedOperation(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "hello", nil
+					},
+				},
+			},
+		}),
+		Extensions: []graphql.Extension{&graphql.Tracing{}},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	tests := []struct {
+		requestString string
+		operationName string
+		expected      string
+	}{
+		{`{ greeting }`, "", ""},
+		{`query Greeting { greeting }`, "", "Greeting"},
+		{`query First { greeting } query Second { greeting }`, "Second", "Second"},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: test.requestString,
+			OperationName: test.operationName,
+		})
+		if len(result.Errors) != 0 {
+			t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+		}
+		tracing := result.Extensions["tracing"].(*graphql.TracingResult)
+		if tracing.OperationName != test.expected {
+			t.Fatalf("expected operation name %q for %v, got %q", test.expected, test.requestString, tracing.OperationName)
+		}
+	}
+}
+
+func TestTracing_ReportsTheNameOfAnOperationNoResolverRanFor(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return "hello", nil
+					},
+				},
+			},
+		}),
+		Extensions: []graphql.Extension{&graphql.Tracing{}},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `query Greetings { greeting other: greeting }`,
+		MaxRootFields: 1,
+	})
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected a single error, got: %v", result.Errors)
+	}
+	tracing := result.Extensions["tracing"].(*graphql.TracingResult)
+	if len(tracing.Resolvers) != 0 {
+		t.Fatalf("expected no resolver to run, got: %v", tracing.Resolvers)
+	}
+	if tracing.OperationName != "Greetings" {
+		t.Fatalf("expected operation name %q, got %q", "Greetings", tracing.OperationName)
+	}
+}
@@ -64,6 +64,16 @@ func (ti *TypeInfo) InputType() Input {
 	}
 	return nil
 }
+
+// ParentInputType returns the input type the current input type is nested in,
+// such as the input object of an input field.
+func (ti *TypeInfo) ParentInputType() Input {
+	if len(ti.inputTypeStack) > 1 {
+		return ti.inputTypeStack[len(ti.inputTypeStack)-2]
+	}
+	return nil
+}
+
 func (ti *TypeInfo) FieldDef() *FieldDefinition {
 	if len(ti.fieldDefStack) > 0 {
 		return ti.fieldDefStack[len(ti.fieldDefStack)-1]
@@ -11,6 +11,10 @@ type Result struct {
 	Data       interface{}                `json:"data"`
 	Errors     []gqlerrors.FormattedError `json:"errors,omitempty"`
 	Extensions map[string]interface{}     `json:"extensions,omitempty"`
+
+	// Warnings report problems with the request that, unlike errors, did not
+	// prevent it from being executed, such as the use of deprecated fields.
+	Warnings []gqlerrors.FormattedError `json:"warnings,omitempty"`
 }
 
 // HasErrors just a simple function to help you decide if the result has errors or not
@@ -3,6 +3,7 @@ package graphql_test
 import (
 	"context"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -261,6 +262,150 @@ func TestUnionIntersectionTypes_ExecutesUsingUnionTypes(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+func TestUnionIntersectionTypes_ResolvesTypenameAliasedAndInsideFragments(t *testing.T) {
+	doc := `
+      {
+        kind: __typename
+        ...PersonFields
+      }
+      fragment PersonFields on Person {
+        friends {
+          __typename
+          ... on Dog {
+            kind: __typename
+          }
+        }
+        pets {
+          ...PetKind
+        }
+      }
+      fragment PetKind on Pet {
+        __typename
+      }
+	`
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"kind": "Person",
+			"friends": []interface{}{
+				map[string]interface{}{
+					"__typename": "Person",
+				},
+				map[string]interface{}{
+					"__typename": "Dog",
+					"kind":       "Dog",
+				},
+			},
+			"pets": []interface{}{
+				map[string]interface{}{
+					"__typename": "Cat",
+				},
+				map[string]interface{}{
+					"__typename": "Dog",
+				},
+			},
+		},
+	}
+	ast := testutil.TestParse(t, doc)
+	ep := graphql.ExecuteParams{
+		Schema: unionInterfaceTestSchema,
+		AST:    ast,
+		Root:   john,
+	}
+	result := testutil.TestExecute(t, ep)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestUnionIntersectionTypes_ListsThePossibleTypesOfInterfacesAndUnions(t *testing.T) {
+	nodeType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.ID},
+		},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return nil
+		},
+	})
+	newType := func(name string, interfaces ...*graphql.Interface) *graphql.Object {
+		return graphql.NewObject(graphql.ObjectConfig{
+			Name:       name,
+			Interfaces: interfaces,
+			Fields: graphql.Fields{
+				"id": &graphql.Field{Type: graphql.ID},
+			},
+			IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+				return false
+			},
+		})
+	}
+	userType := newType("User", nodeType)
+	postType := newType("Post", nodeType)
+	tagType := newType("Tag")
+	resultType := graphql.NewUnion(graphql.UnionConfig{
+		Name:  "SearchResult",
+		Types: []*graphql.Object{userType, tagType},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"node":   &graphql.Field{Type: nodeType},
+				"search": &graphql.Field{Type: graphql.NewList(resultType)},
+			},
+		}),
+		Types: []graphql.Type{userType, postType},
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	names := func(types []*graphql.Object) []string {
+		names := []string{}
+		for _, ttype := range types {
+			names = append(names, ttype.Name())
+		}
+		sort.Strings(names)
+		return names
+	}
+	if expected, got := []string{"Post", "User"}, names(schema.PossibleTypes(nodeType)); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Unexpected possible types of Node, Diff: %v", testutil.Diff(expected, got))
+	}
+	if expected, got := []string{"Tag", "User"}, names(schema.PossibleTypes(resultType)); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Unexpected possible types of SearchResult, Diff: %v", testutil.Diff(expected, got))
+	}
+
+	tests := []struct {
+		abstractType graphql.Abstract
+		objectType   *graphql.Object
+		expected     bool
+	}{
+		{nodeType, userType, true},
+		{nodeType, postType, true},
+		{nodeType, tagType, false},
+		{resultType, userType, true},
+		{resultType, tagType, true},
+		{resultType, postType, false},
+	}
+	for _, test := range tests {
+		if got := schema.IsPossibleType(test.abstractType, test.objectType); got != test.expected {
+			t.Fatalf("expected IsPossibleType(%v, %v) to be %v, got %v", test.abstractType, test.objectType, test.expected, got)
+		}
+	}
+
+	// types appended later are possible types too, and are not listed twice
+	commentType := newType("Comment", nodeType)
+	if err := schema.AppendType(commentType); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected, got := []string{"Comment", "Post", "User"}, names(schema.PossibleTypes(nodeType)); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("Unexpected possible types of Node, Diff: %v", testutil.Diff(expected, got))
+	}
+	if !schema.IsPossibleType(nodeType, commentType) {
+		t.Fatalf("expected Comment to be a possible type of Node")
+	}
+}
+
 func TestUnionIntersectionTypes_ExecutesUnionTypesWithInlineFragments(t *testing.T) {
 	// This is the valid version of the query in the above test.
 	doc := `
@@ -418,6 +563,101 @@ func TestUnionIntersectionTypes_ExecutesInterfaceTypesWithInlineFragments(t *tes
 	}
 }
 
+func TestUnionIntersectionTypes_CompletesAliasedSelectionsPerItemOfMixedLists(t *testing.T) {
+	tom := &testCat2{"Tom", true}
+	ann := &testPerson{
+		Name:    "Ann",
+		Pets:    []testPet{odie, tom},
+		Friends: []testNamedType{tom},
+	}
+	root := &testPerson{
+		Name:    "Root",
+		Pets:    []testPet{garfield, odie, tom},
+		Friends: []testNamedType{odie, ann, garfield},
+	}
+	doc := `
+      {
+        animals: pets {
+          kind: __typename
+          ... on Dog { called: name, noise: barks }
+          ... on Cat { called: name, noise: meows }
+        }
+        buddies: friends {
+          kind: __typename
+          called: name
+          ... on Dog { noise: barks }
+          ... on Cat { noise: meows }
+          ... on Person {
+            animals: pets {
+              ... on Named { called: name }
+              ... on Cat { noise: meows }
+            }
+            buddies: friends { called: name }
+          }
+        }
+      }
+	`
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"animals": []interface{}{
+				map[string]interface{}{
+					"kind":   "Cat",
+					"called": "Garfield",
+					"noise":  false,
+				},
+				map[string]interface{}{
+					"kind":   "Dog",
+					"called": "Odie",
+					"noise":  true,
+				},
+				map[string]interface{}{
+					"kind":   "Cat",
+					"called": "Tom",
+					"noise":  true,
+				},
+			},
+			"buddies": []interface{}{
+				map[string]interface{}{
+					"kind":   "Dog",
+					"called": "Odie",
+					"noise":  true,
+				},
+				map[string]interface{}{
+					"kind":   "Person",
+					"called": "Ann",
+					"animals": []interface{}{
+						map[string]interface{}{
+							"called": "Odie",
+						},
+						map[string]interface{}{
+							"called": "Tom",
+							"noise":  true,
+						},
+					},
+					"buddies": []interface{}{
+						map[string]interface{}{
+							"called": "Tom",
+						},
+					},
+				},
+				map[string]interface{}{
+					"kind":   "Cat",
+					"called": "Garfield",
+					"noise":  false,
+				},
+			},
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        unionInterfaceTestSchema,
+		RequestString: doc,
+		RootValue:     root,
+	})
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
 func TestUnionIntersectionTypes_AllowsFragmentConditionsToBeAbstractTypes(t *testing.T) {
 
 	doc := `
@@ -160,6 +160,33 @@ func extractTag(tag reflect.StructTag) string {
 	return t
 }
 
+// DiscriminatorField is the name of the struct field that
+// ResolveTypeByDiscriminator reads to tell apart the members of a tagged union.
+const DiscriminatorField = "Type"
+
+// ResolveTypeByDiscriminator returns a ResolveTypeFn for abstract types whose
+// values are tagged union structs. It reads the struct's "Type" field and
+// returns the object type registered for that value in types, or nil if the
+// value carries no known discriminator.
+// e.g
+// type Pet struct{
+//	Type string // "Dog" or "Cat"
+//	Name string
+// }
+func ResolveTypeByDiscriminator(types map[string]*Object) ResolveTypeFn {
+	return func(p ResolveTypeParams) *Object {
+		val := reflect.Indirect(reflect.ValueOf(p.Value))
+		if val.Kind() != reflect.Struct {
+			return nil
+		}
+		discriminator := reflect.Indirect(val.FieldByName(DiscriminatorField))
+		if !discriminator.IsValid() || !discriminator.CanInterface() {
+			return nil
+		}
+		return types[fmt.Sprint(discriminator.Interface())]
+	}
+}
+
 // lazy way of binding args
 func BindArg(obj interface{}, tags ...string) FieldConfigArgument {
 	v := reflect.Indirect(reflect.ValueOf(obj))
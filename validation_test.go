@@ -1,10 +1,12 @@
 package graphql_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
 )
 
 var someScalarType = graphql.NewScalar(graphql.ScalarConfig{
@@ -385,6 +387,65 @@ func TestTypeSystem_SchemaMustContainUniquelyNamedTypes_RejectsASchemaWhichDefin
 		t.Fatalf("Expected error: %v, got %v", expectedError, err)
 	}
 }
+func TestTypeSystem_SchemaMustContainUniquelyNamedTypes_RejectsTypesOfDifferentKindsSharingAName(t *testing.T) {
+
+	pointObject := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Point",
+		Fields: graphql.Fields{
+			"x": &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+	})
+	pointInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Point",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"x": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"points": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(pointObject)),
+			},
+		},
+	})
+	expectedError := `Schema must contain unique named types but contains multiple types named "Point".`
+
+	// the input object is only used by an argument of a directive
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+		Directives: []*graphql.Directive{
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name:      "near",
+				Locations: []string{graphql.DirectiveLocationField},
+				Args: graphql.FieldConfigArgument{
+					"point": &graphql.ArgumentConfig{
+						Type: pointInput,
+					},
+				},
+			}),
+		},
+	})
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+
+	// the input object is appended once the schema is built
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = schema.AppendType(pointInput)
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+}
 func TestTypeSystem_SchemaMustContainUniquelyNamedTypes_RejectsASchemaWhichHaveSameNamedObjectsImplementingAnInterface(t *testing.T) {
 
 	anotherInterface := graphql.NewInterface(graphql.InterfaceConfig{
@@ -789,6 +850,33 @@ func TestTypeSystem_InterfaceTypesMustBeResolvable_AcceptsAnInterfaceTypeDefinin
 	}
 }
 
+func TestTypeSystem_InterfaceTypesMustBeResolvable_RejectsAnInterfaceTypeNotDefiningResolveTypeWithImplementingTypeNotDefiningIsTypeOf(t *testing.T) {
+
+	anotherInterfaceType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "AnotherInterface",
+		Fields: graphql.Fields{
+			"f": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	_, err := schemaWithFieldType(graphql.NewObject(graphql.ObjectConfig{
+		Name:       "SomeObject",
+		Interfaces: []*graphql.Interface{anotherInterfaceType},
+		Fields: graphql.Fields{
+			"f": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	}))
+	expectedError := `Interface Type AnotherInterface does not provide a "resolveType" function and ` +
+		`implementing Type SomeObject does not provide a "isTypeOf" function. ` +
+		`There is no way to resolve this implementing type during execution.`
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+}
+
 func TestTypeSystem_UnionTypesMustBeResolvable_AcceptsAUnionTypeDefiningResolveType(t *testing.T) {
 
 	_, err := schemaWithFieldType(graphql.NewUnion(graphql.UnionConfig{
@@ -1644,3 +1732,60 @@ func TestTypeSystem_ObjectsMustAdhereToInterfaceTheyImplement_RejectsAnObjectWit
 		t.Fatalf("Expected error: %v, got %v", expectedError, err)
 	}
 }
+
+func TestTypeSystem_StrictArguments_WarnsAboutNonNullArgumentsWithADefaultValue(t *testing.T) {
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"greet": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{
+						Type:         graphql.NewNonNull(graphql.String),
+						DefaultValue: "World",
+					},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warnings := schema.Warnings(); len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+
+	schema, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query:           queryType,
+		StrictArguments: true,
+		Directives: []*graphql.Directive{
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name:      "cached",
+				Locations: []string{graphql.DirectiveLocationField},
+				Args: graphql.FieldConfigArgument{
+					"ttl": &graphql.ArgumentConfig{
+						Type:         graphql.NewNonNull(graphql.Int),
+						DefaultValue: 60,
+					},
+				},
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedWarnings := []string{
+		`Query.greet(name:) is of non-null type "String!" but has a default value, so it is not required.`,
+		`@cached(ttl:) is of non-null type "Int!" but has a default value, so it is not required.`,
+	}
+	warnings := []string{}
+	for _, warning := range schema.Warnings() {
+		warnings = append(warnings, warning.Error())
+	}
+	if !reflect.DeepEqual(expectedWarnings, warnings) {
+		t.Fatalf("Unexpected warnings, Diff: %v", testutil.Diff(expectedWarnings, warnings))
+	}
+}
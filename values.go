@@ -6,6 +6,7 @@ import (
 	"math"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/graphql-go/graphql/gqlerrors"
@@ -36,6 +37,27 @@ func getVariableValues(
 	return values, nil
 }
 
+// ValidateVariables checks the given variable values against the variable
+// definitions of an operation of the document without executing it, and
+// reports every variable that is missing or of the wrong type. The operation
+// is picked by name as for Execute.
+func ValidateVariables(schema Schema, doc *ast.Document, operationName string, variables map[string]interface{}) []gqlerrors.FormattedError {
+	operation, err := getOperation(doc, operationName)
+	if err != nil {
+		return gqlerrors.FormatErrors(err)
+	}
+	var errs []gqlerrors.FormattedError
+	for _, defAST := range operation.GetVariableDefinitions() {
+		if defAST == nil || defAST.Variable == nil || defAST.Variable.Name == nil {
+			continue
+		}
+		if _, err := getVariableValue(schema, defAST, variables[defAST.Variable.Name.Value]); err != nil {
+			errs = append(errs, gqlerrors.FormatError(err))
+		}
+	}
+	return errs
+}
+
 // Prepares an object map of argument values given a list of argument
 // definitions and list of argument AST nodes.
 func getArgumentValues(
@@ -129,6 +151,17 @@ func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, inpu
 	)
 }
 
+// CoerceInputValue validates the given value against an input type, the same
+// way variable values are validated, and returns the value coerced to match
+// the type. If the value is invalid, it returns nil along with messages
+// describing the problems.
+func CoerceInputValue(value interface{}, ttype Input) (interface{}, []string) {
+	if ok, messages := isValidInputValue(value, ttype); !ok {
+		return nil, messages
+	}
+	return coerceValue(ttype, value), nil
+}
+
 // Given a type and any value, return a runtime value coerced to match the type.
 func coerceValue(ttype Input, value interface{}) interface{} {
 	if isNullish(value) {
@@ -157,6 +190,8 @@ func coerceValue(ttype Input, value interface{}) interface{} {
 
 		for name, field := range ttype.Fields() {
 			fieldValue := coerceValue(field.Type, valueMap[name])
+			// The default value fills in fields that are either absent or
+			// null, so a null never overrides a default.
 			if isNullish(fieldValue) {
 				fieldValue = field.DefaultValue
 			}
@@ -234,8 +269,8 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 			for i := 0; i < valType.Len(); i++ {
 				val := valType.Index(i).Interface()
 				_, messages := isValidInputValue(val, ttype.OfType)
-				for idx, message := range messages {
-					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
+				for _, message := range messages {
+					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, i+1, message))
 				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce
@@ -281,6 +316,16 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 				}
 			}
 		}
+
+		// Ensure exactly one field of a OneOf input object is given, and that
+		// it is not null.
+		if ttype.IsOneOf() {
+			if len(valueMap) != 1 {
+				messagesReduce = append(messagesReduce, fmt.Sprintf(`Exactly one field must be given for OneOf type "%v".`, ttype.Name()))
+			} else if fieldName := valueMapFieldNames[0]; isNullish(valueMap[fieldName]) {
+				messagesReduce = append(messagesReduce, fmt.Sprintf(`Field "%v.%v" must be non-null.`, ttype.Name(), fieldName))
+			}
+		}
 		return (len(messagesReduce) == 0), messagesReduce
 	case *Scalar:
 		if parsedVal := ttype.ParseValue(value); isNullish(parsedVal) {
@@ -301,22 +346,18 @@ func isNullish(src interface{}) bool {
 		return true
 	}
 	value := reflect.ValueOf(src)
-	if value.Kind() == reflect.Ptr {
-		if value.IsNil() {
-			return true
-		}
-		value = value.Elem()
-	}
 	switch value.Kind() {
-	case reflect.String:
-		// if src is ptr type and len(string)=0, it returns false
-		if !value.IsValid() {
+	case reflect.Ptr:
+		// a nil pointer is null whatever it points to
+		if value.IsNil() {
 			return true
 		}
-	case reflect.Int:
-		return math.IsNaN(float64(value.Int()))
+		return isNullish(value.Elem().Interface())
+	case reflect.Map:
+		// unlike a nil slice, which is an empty list, a nil map holds no value
+		return value.IsNil()
 	case reflect.Float32, reflect.Float64:
-		return math.IsNaN(float64(value.Float()))
+		return math.IsNaN(value.Float())
 	}
 	return false
 }
@@ -395,7 +436,10 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]interfac
 			var value interface{}
 			if of, ok = fieldASTs[name]; ok {
 				value = valueFromAST(of.Value, field.Type, variables)
-			} else {
+			}
+			// As in coerceValue, the default value fills in fields that are
+			// either absent or null, so a null never overrides a default.
+			if isNullish(value) {
 				value = field.DefaultValue
 			}
 			if !isNullish(value) {
@@ -404,7 +448,7 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]interfac
 		}
 		return obj
 	case *Scalar:
-		return ttype.ParseLiteral(valueAST)
+		return ttype.parseLiteral(valueAST, variables)
 	case *Enum:
 		return ttype.ParseLiteral(valueAST)
 	}
@@ -412,6 +456,52 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]interfac
 	return nil
 }
 
+// valueFromASTUntyped produces a Go value from a GraphQL Value AST without
+// any type information, using the variables scope to fulfill any variable
+// references. Integers too large for an int are produced as floats.
+func valueFromASTUntyped(valueAST ast.Value, variables map[string]interface{}) interface{} {
+	switch valueAST := valueAST.(type) {
+	case *ast.Variable:
+		if valueAST.Name == nil || variables == nil {
+			return nil
+		}
+		return variables[valueAST.Name.Value]
+	case *ast.IntValue:
+		if intValue, err := strconv.ParseInt(valueAST.Value, 10, 64); err == nil {
+			return int(intValue)
+		}
+		if floatValue, err := strconv.ParseFloat(valueAST.Value, 64); err == nil {
+			return floatValue
+		}
+	case *ast.FloatValue:
+		if floatValue, err := strconv.ParseFloat(valueAST.Value, 64); err == nil {
+			return floatValue
+		}
+	case *ast.StringValue:
+		return valueAST.Value
+	case *ast.BooleanValue:
+		return valueAST.Value
+	case *ast.EnumValue:
+		return valueAST.Value
+	case *ast.ListValue:
+		values := make([]interface{}, 0, len(valueAST.Values))
+		for _, itemAST := range valueAST.Values {
+			values = append(values, valueFromASTUntyped(itemAST, variables))
+		}
+		return values
+	case *ast.ObjectValue:
+		obj := make(map[string]interface{}, len(valueAST.Fields))
+		for _, field := range valueAST.Fields {
+			if field == nil || field.Name == nil {
+				continue
+			}
+			obj[field.Name.Value] = valueFromASTUntyped(field.Value, variables)
+		}
+		return obj
+	}
+	return nil
+}
+
 func invariant(condition bool, message string) error {
 	if !condition {
 		return gqlerrors.NewFormattedError(message)
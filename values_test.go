@@ -1,6 +1,10 @@
 package graphql
 
-import "testing"
+import (
+	"math"
+	"reflect"
+	"testing"
+)
 
 func TestIsIterable(t *testing.T) {
 	if !isIterable([]int{}) {
@@ -16,3 +20,58 @@ func TestIsIterable(t *testing.T) {
 		t.Fatal("expected isIterable to return false for nil, got true")
 	}
 }
+
+func TestIsNullish(t *testing.T) {
+	type testStruct struct{}
+	one := 1
+	empty := ""
+	nan := math.NaN()
+	tests := []struct {
+		value    interface{}
+		expected bool
+	}{
+		{nil, true},
+		{(*int)(nil), true},
+		{(*string)(nil), true},
+		{(*testStruct)(nil), true},
+		{(**int)(nil), true},
+		{&one, false},
+		{&empty, false},
+		{&testStruct{}, false},
+		{[]int(nil), false},
+		{[]int{}, false},
+		{map[string]interface{}(nil), true},
+		{map[string]interface{}{}, false},
+		{0, false},
+		{"", false},
+		{nan, true},
+		{&nan, true},
+		{float32(1.5), false},
+	}
+	for i, test := range tests {
+		if got := isNullish(test.value); got != test.expected {
+			t.Errorf("%d: isNullish(%#v) = %v, want %v", i, test.value, got, test.expected)
+		}
+	}
+}
+
+func TestCoerceValue_KeepsNullElementsOfNullableLists(t *testing.T) {
+	coerced := coerceValue(NewList(String), []interface{}{"A", nil, "B"})
+	expected := []interface{}{"A", nil, "B"}
+	if !reflect.DeepEqual(coerced, expected) {
+		t.Fatalf("expected %v, got %v", expected, coerced)
+	}
+}
+
+func TestIsValidInputValue_ReportsNullElementsOfNonNullItemLists(t *testing.T) {
+	valid, messages := isValidInputValue([]interface{}{"A", "B", nil}, NewList(NewNonNull(String)))
+	expected := []string{`In element #3: Expected "String!", found null.`}
+	if valid || !reflect.DeepEqual(messages, expected) {
+		t.Fatalf("expected %v, got %v", expected, messages)
+	}
+
+	valid, messages = isValidInputValue([]interface{}{"A", nil, "B"}, NewList(String))
+	if !valid || len(messages) != 0 {
+		t.Fatalf("expected a null element to be valid for a nullable item type, got %v", messages)
+	}
+}
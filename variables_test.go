@@ -3,6 +3,7 @@ package graphql_test
 import (
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -1188,7 +1189,7 @@ func TestVariables_ListsAndNullability_DoesNotAllowListOfNonNullsToContainNull(t
 			{
 				Message: `Variable "$input" got invalid value ` +
 					`["A",null,"B"].` +
-					"\nIn element #1: Expected \"String!\", found null.",
+					"\nIn element #2: Expected \"String!\", found null.",
 				Locations: []location.SourceLocation{
 					{
 						Line: 2, Column: 17,
@@ -1290,7 +1291,7 @@ func TestVariables_ListsAndNullability_DoesNotAllowNonNullListOfNonNullsToContai
 			{
 				Message: `Variable "$input" got invalid value ` +
 					`["A",null,"B"].` +
-					"\nIn element #1: Expected \"String!\", found null.",
+					"\nIn element #2: Expected \"String!\", found null.",
 				Locations: []location.SourceLocation{
 					{
 						Line: 2, Column: 17,
@@ -1466,3 +1467,785 @@ func TestVariables_UsesArgumentDefaultValues_WhenArgumentProvidedCannotBeParsed(
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+var numbersTestSchema, _ = graphql.NewSchema(graphql.SchemaConfig{
+	Query: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"int": &graphql.Field{
+				Type: graphql.Int,
+				Args: graphql.FieldConfigArgument{
+					"value": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Args["value"], nil
+				},
+			},
+			"float": &graphql.Field{
+				Type: graphql.Float,
+				Args: graphql.FieldConfigArgument{
+					"value": &graphql.ArgumentConfig{Type: graphql.Float},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Args["value"], nil
+				},
+			},
+		},
+	}),
+})
+
+func TestVariables_JSONNumbers_AreCoercedToIntAndFloat(t *testing.T) {
+	decodeVariables := func(s string) map[string]interface{} {
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+		variables := map[string]interface{}{}
+		if err := decoder.Decode(&variables); err != nil {
+			t.Fatalf("Error decoding variables %v", err.Error())
+		}
+		return variables
+	}
+	query := `query ($i: Int, $f: Float) { int(value: $i) float(value: $f) }`
+
+	result := graphql.Do(graphql.Params{
+		Schema:         numbersTestSchema,
+		RequestString:  query,
+		VariableValues: decodeVariables(`{"i": 42, "f": 3.25}`),
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"int":   42,
+			"float": 3.25,
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:         numbersTestSchema,
+		RequestString:  query,
+		VariableValues: decodeVariables(`{"i": 4000000000, "f": 1}`),
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: "Variable \"$i\" got invalid value 4000000000.\nExpected type \"Int\", found \"4000000000\".",
+			Locations: []location.SourceLocation{
+				{Line: 1, Column: 8},
+			},
+		},
+	}
+	if result.Data != nil {
+		t.Fatalf("wrong result, expected nil result.Data, got %v", result.Data)
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+}
+
+func TestVariables_Int_RejectsValuesOutsideThe32BitRangeAndFractionalValues(t *testing.T) {
+	query := `query ($i: Int) { int(value: $i) }`
+	tests := []struct {
+		value    interface{}
+		expected interface{}
+		message  string
+	}{
+		{value: 2147483647, expected: 2147483647},
+		{value: -2147483648, expected: -2147483648},
+		{value: 3.0, expected: 3},
+		{
+			value:   4000000000,
+			message: "Variable \"$i\" got invalid value 4000000000.\nExpected type \"Int\", found \"4000000000\".",
+		},
+		{
+			value:   -2147483649,
+			message: "Variable \"$i\" got invalid value -2147483649.\nExpected type \"Int\", found \"-2147483649\".",
+		},
+		{
+			value:   3.5,
+			message: "Variable \"$i\" got invalid value 3.5.\nExpected type \"Int\", found \"3.5\".",
+		},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         numbersTestSchema,
+			RequestString:  query,
+			VariableValues: map[string]interface{}{"i": test.value},
+		})
+		if test.message == "" {
+			expected := &graphql.Result{
+				Data: map[string]interface{}{
+					"int": test.expected,
+				},
+			}
+			if !reflect.DeepEqual(expected, result) {
+				t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+			}
+			continue
+		}
+		expectedErrors := []gqlerrors.FormattedError{
+			{
+				Message: test.message,
+				Locations: []location.SourceLocation{
+					{Line: 1, Column: 8},
+				},
+			},
+		}
+		if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+			t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+		}
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        numbersTestSchema,
+		RequestString: `{ int(value: 4000000000) }`,
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: "Argument \"value\" has invalid value 4000000000.\nExpected type \"Int\", found 4000000000.",
+			Locations: []location.SourceLocation{
+				{Line: 1, Column: 14},
+			},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+}
+
+func TestVariables_FragmentsUseTheVariablesOfTheExecutingOperation(t *testing.T) {
+	itemsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Items",
+		Fields: graphql.Fields{
+			"first": &graphql.Field{
+				Type: graphql.NewList(graphql.Int),
+				Args: graphql.FieldConfigArgument{
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					items := []interface{}{}
+					for i := 1; i <= p.Args["limit"].(int); i++ {
+						items = append(items, i)
+					}
+					return items, nil
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"items": &graphql.Field{
+					Type: itemsType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return map[string]interface{}{}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	doc := `
+		fragment limitedItems on Items {
+			first(limit: $limit)
+		}
+
+		query Small($limit: Int = 1) {
+			items {
+				...limitedItems
+			}
+		}
+
+		query Large($limit: Int) {
+			items {
+				...limitedItems
+			}
+		}
+	`
+	tests := []struct {
+		operationName string
+		variables     map[string]interface{}
+		expected      []interface{}
+	}{
+		{"Small", nil, []interface{}{1}},
+		{"Small", map[string]interface{}{"limit": 2}, []interface{}{1, 2}},
+		{"Large", map[string]interface{}{"limit": 3}, []interface{}{1, 2, 3}},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  doc,
+			OperationName:  test.operationName,
+			VariableValues: test.variables,
+		})
+		expected := &graphql.Result{
+			Data: map[string]interface{}{
+				"items": map[string]interface{}{
+					"first": test.expected,
+				},
+			},
+		}
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+		}
+	}
+}
+
+func TestVariables_InputObjectDefaults_AreAppliedIdenticallyToVariablesAndLiterals(t *testing.T) {
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"a": &graphql.InputObjectFieldConfig{
+				Type:         graphql.String,
+				DefaultValue: "default",
+			},
+			"b": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"filter": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"filter": &graphql.ArgumentConfig{Type: filterType},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						out, err := json.Marshal(p.Args["filter"])
+						return string(out), err
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	tests := []struct {
+		name          string
+		query         string
+		variableValue map[string]interface{}
+		expected      string
+	}{
+		{
+			name:          "variable with an absent field",
+			query:         `query ($f: Filter) { filter(filter: $f) }`,
+			variableValue: map[string]interface{}{"f": map[string]interface{}{"b": "b"}},
+			expected:      `{"a":"default","b":"b"}`,
+		},
+		{
+			name:          "literal with an absent field",
+			query:         `{ filter(filter: {b: "b"}) }`,
+			variableValue: nil,
+			expected:      `{"a":"default","b":"b"}`,
+		},
+		{
+			name:          "variable with a null field",
+			query:         `query ($f: Filter) { filter(filter: $f) }`,
+			variableValue: map[string]interface{}{"f": map[string]interface{}{"a": nil, "b": "b"}},
+			expected:      `{"a":"default","b":"b"}`,
+		},
+		{
+			name:          "literal with a null field",
+			query:         `query ($a: String) { filter(filter: {a: $a, b: "b"}) }`,
+			variableValue: map[string]interface{}{"a": nil},
+			expected:      `{"a":"default","b":"b"}`,
+		},
+		{
+			name:          "variable with a value",
+			query:         `query ($f: Filter) { filter(filter: $f) }`,
+			variableValue: map[string]interface{}{"f": map[string]interface{}{"a": "a"}},
+			expected:      `{"a":"a"}`,
+		},
+		{
+			name:          "literal with a value",
+			query:         `{ filter(filter: {a: "a"}) }`,
+			variableValue: nil,
+			expected:      `{"a":"a"}`,
+		},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  test.query,
+			VariableValues: test.variableValue,
+		})
+		expected := &graphql.Result{
+			Data: map[string]interface{}{
+				"filter": test.expected,
+			},
+		}
+		if !reflect.DeepEqual(expected, result) {
+			t.Fatalf("%v: Unexpected result, Diff: %v", test.name, testutil.Diff(expected, result))
+		}
+	}
+}
+
+func TestVariables_ListsAndNullability_ReportsThePathOfABadNestedElement(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"matrix": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"input": &graphql.ArgumentConfig{
+							Type: graphql.NewList(graphql.NewList(graphql.Int)),
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	doc := `
+        query q($input: [[Int]]) {
+          matrix(input: $input)
+        }
+	`
+	params := map[string]interface{}{
+		"input": []interface{}{
+			[]interface{}{1, 2},
+			[]interface{}{"three", 4},
+		},
+	}
+	expected := &graphql.Result{
+		Data: nil,
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: `Variable "$input" got invalid value ` +
+					`[[1,2],["three",4]].` +
+					"\nIn element #2: In element #1: Expected type \"Int\", found \"three\".",
+				Locations: []location.SourceLocation{
+					{
+						Line: 2, Column: 17,
+					},
+				},
+			},
+		},
+	}
+	ast := testutil.TestParse(t, doc)
+
+	// execute
+	ep := graphql.ExecuteParams{
+		Schema: schema,
+		AST:    ast,
+		Args:   params,
+	}
+	result := testutil.TestExecute(t, ep)
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestVariables_ListsAndNullability_ReportsThePositionOfAnInvalidMiddleElement(t *testing.T) {
+	doc := `
+        query q($input: [String!]) {
+          listNN(input: $input)
+        }
+	`
+	params := map[string]interface{}{
+		"input": []interface{}{"A", "B", nil, "D", "E"},
+	}
+	expected := &graphql.Result{
+		Data: nil,
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message: `Variable "$input" got invalid value ` +
+					`["A","B",null,"D","E"].` +
+					"\nIn element #3: Expected \"String!\", found null.",
+				Locations: []location.SourceLocation{
+					{
+						Line: 2, Column: 17,
+					},
+				},
+			},
+		},
+	}
+	ast := testutil.TestParse(t, doc)
+
+	// execute
+	ep := graphql.ExecuteParams{
+		Schema: variablesTestSchema,
+		AST:    ast,
+		Args:   params,
+	}
+	result := testutil.TestExecute(t, ep)
+	if !testutil.EqualResults(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestVariables_CoerceInputValue_CoercesInputObjects(t *testing.T) {
+	coerced, messages := graphql.CoerceInputValue(map[string]interface{}{
+		"a": "foo",
+		"b": []interface{}{"bar"},
+		"c": "baz",
+	}, testInputObject)
+	expected := map[string]interface{}{
+		"a": "foo",
+		"b": []interface{}{"bar"},
+		"c": "baz",
+	}
+	if len(messages) > 0 {
+		t.Fatalf("unexpected messages: %v", messages)
+	}
+	if !reflect.DeepEqual(expected, coerced) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, coerced))
+	}
+
+	coerced, messages = graphql.CoerceInputValue(map[string]interface{}{
+		"a": "foo",
+		"b": "bar",
+		"e": "dog",
+	}, testInputObject)
+	expectedMessages := []string{
+		`In field "e": Unknown field.`,
+		`In field "c": Expected "String!", found null.`,
+	}
+	if coerced != nil {
+		t.Fatalf("expected no coerced value, got %v", coerced)
+	}
+	if !reflect.DeepEqual(expectedMessages, messages) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedMessages, messages))
+	}
+}
+
+func TestVariables_EmptyInputObjects_AreCoercedToEmptyMapsWithDefaults(t *testing.T) {
+	defaultedType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Defaulted",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"a": &graphql.InputObjectFieldConfig{
+				Type:         graphql.String,
+				DefaultValue: "default",
+			},
+		},
+	})
+	plainType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Plain",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"b": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	resolveArg := func(p graphql.ResolveParams) (interface{}, error) {
+		out, err := json.Marshal(p.Args["input"])
+		return string(out), err
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"defaulted": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"input": &graphql.ArgumentConfig{Type: defaultedType},
+					},
+					Resolve: resolveArg,
+				},
+				"plain": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"input": &graphql.ArgumentConfig{Type: plainType},
+					},
+					Resolve: resolveArg,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	query := `
+		query ($d: Defaulted, $p: Plain) {
+			defaulted(input: $d)
+			plain(input: $p)
+			defaultedLiteral: defaulted(input: {})
+			plainLiteral: plain(input: {})
+			absent: plain
+		}
+	`
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		VariableValues: map[string]interface{}{
+			"d": map[string]interface{}{},
+			"p": map[string]interface{}{},
+		},
+	})
+	expected := &graphql.Result{
+		Data: map[string]interface{}{
+			"defaulted":        `{"a":"default"}`,
+			"plain":            `{}`,
+			"defaultedLiteral": `{"a":"default"}`,
+			"plainLiteral":     `{}`,
+			"absent":           `null`,
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestVariables_ValidateVariables_ReportsEveryInvalidVariableWithoutExecuting(t *testing.T) {
+	doc := testutil.TestParse(t, `
+        query Other { fieldWithNullableStringInput }
+        query SetsVariables($value: String!, $list: [String!], $optional: String) {
+          fieldWithNonNullableStringInput(input: $value)
+          list(input: $list)
+          fieldWithNullableStringInput(input: $optional)
+        }
+	`)
+
+	errs := graphql.ValidateVariables(variablesTestSchema, doc, "SetsVariables", map[string]interface{}{
+		"list": []interface{}{"a", nil},
+	})
+	expected := []gqlerrors.FormattedError{
+		{
+			Message: `Variable "$value" of required type "String!" was not provided.`,
+			Locations: []location.SourceLocation{
+				{
+					Line: 3, Column: 29,
+				},
+			},
+		},
+		{
+			Message: `Variable "$list" got invalid value ["a",null].` +
+				"\nIn element #2: Expected \"String!\", found null.",
+			Locations: []location.SourceLocation{
+				{
+					Line: 3, Column: 46,
+				},
+			},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expected, errs) {
+		t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(expected, errs))
+	}
+
+	errs = graphql.ValidateVariables(variablesTestSchema, doc, "SetsVariables", map[string]interface{}{
+		"value": "a",
+	})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got: %v", errs)
+	}
+
+	errs = graphql.ValidateVariables(variablesTestSchema, doc, "", nil)
+	expected = []gqlerrors.FormattedError{
+		{
+			Message:   "Must provide operation name if query contains multiple operations.",
+			Locations: []location.SourceLocation{},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expected, errs) {
+		t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(expected, errs))
+	}
+}
+
+func TestVariables_OneOfInputObjects_AcceptExactlyOneField(t *testing.T) {
+	userByType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:    "UserBy",
+		IsOneOf: true,
+		Fields: graphql.InputObjectConfigFieldMap{
+			"id": &graphql.InputObjectFieldConfig{
+				Type: graphql.ID,
+			},
+			"email": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"by": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(userByType),
+						},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						b, err := json.Marshal(p.Args["by"])
+						return string(b), err
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+
+	tests := []struct {
+		query     string
+		variables map[string]interface{}
+		expected  *graphql.Result
+	}{
+		{
+			query: `{ user(by: { id: "1" }) }`,
+			expected: &graphql.Result{
+				Data: map[string]interface{}{"user": `{"id":"1"}`},
+			},
+		},
+		{
+			query:     `query ($by: UserBy!) { user(by: $by) }`,
+			variables: map[string]interface{}{"by": map[string]interface{}{"email": "a@example.com"}},
+			expected: &graphql.Result{
+				Data: map[string]interface{}{"user": `{"email":"a@example.com"}`},
+			},
+		},
+		{
+			query: `{ user(by: { id: "1", email: "a@example.com" }) }`,
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Argument "by" has invalid value {id: "1", email: "a@example.com"}.` +
+							"\nExactly one field must be given for OneOf type \"UserBy\".",
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 12},
+						},
+					},
+				},
+			},
+		},
+		{
+			query: `{ user(by: {}) }`,
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Argument "by" has invalid value {}.` +
+							"\nExactly one field must be given for OneOf type \"UserBy\".",
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 12},
+						},
+					},
+				},
+			},
+		},
+		{
+			query:     `query ($by: UserBy!) { user(by: $by) }`,
+			variables: map[string]interface{}{"by": map[string]interface{}{"id": "1", "email": nil}},
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Variable "$by" got invalid value {"email":null,"id":"1"}.` +
+							"\nExactly one field must be given for OneOf type \"UserBy\".",
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 8},
+						},
+					},
+				},
+			},
+		},
+		{
+			query:     `query ($by: UserBy!) { user(by: $by) }`,
+			variables: map[string]interface{}{"by": map[string]interface{}{"id": nil}},
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Variable "$by" got invalid value {"id":null}.` +
+							"\nField \"UserBy.id\" must be non-null.",
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 8},
+						},
+					},
+				},
+			},
+		},
+		{
+			query: `query ($v: String) { user(by: {email: $v}) }`,
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Variable "$v" is of type "String" but must be non-nullable to be used for OneOf Input Object "UserBy".`,
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 8},
+							{Line: 1, Column: 39},
+						},
+					},
+				},
+			},
+		},
+		{
+			query:     `query ($v: String!) { user(by: {email: $v}) }`,
+			variables: map[string]interface{}{"v": "a@example.com"},
+			expected: &graphql.Result{
+				Data: map[string]interface{}{"user": `{"email":"a@example.com"}`},
+			},
+		},
+		{
+			query:     `query ($by: UserBy!) { user(by: $by) }`,
+			variables: map[string]interface{}{"by": map[string]interface{}{"id": "1", "email": "a@example.com"}},
+			expected: &graphql.Result{
+				Errors: []gqlerrors.FormattedError{
+					{
+						Message: `Variable "$by" got invalid value {"email":"a@example.com","id":"1"}.` +
+							"\nExactly one field must be given for OneOf type \"UserBy\".",
+						Locations: []location.SourceLocation{
+							{Line: 1, Column: 8},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  test.query,
+			VariableValues: test.variables,
+		})
+		if !testutil.EqualResults(test.expected, result) {
+			t.Fatalf("Unexpected result for %v, Diff: %v", test.query, testutil.Diff(test.expected, result))
+		}
+	}
+}
+
+func TestVariables_OneOfInputObjects_RejectNonNullAndDefaultedFields(t *testing.T) {
+	tests := []struct {
+		field    *graphql.InputObjectFieldConfig
+		expected string
+	}{
+		{
+			field:    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			expected: `OneOf input field UserBy.id must be nullable.`,
+		},
+		{
+			field:    &graphql.InputObjectFieldConfig{Type: graphql.ID, DefaultValue: "1"},
+			expected: `OneOf input field UserBy.id cannot have a default value.`,
+		},
+	}
+	for _, test := range tests {
+		userByType := graphql.NewInputObject(graphql.InputObjectConfig{
+			Name:    "UserBy",
+			IsOneOf: true,
+			Fields:  graphql.InputObjectConfigFieldMap{"id": test.field},
+		})
+		_, err := graphql.NewSchema(graphql.SchemaConfig{
+			Query: graphql.NewObject(graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"user": &graphql.Field{
+						Type: graphql.String,
+						Args: graphql.FieldConfigArgument{
+							"by": &graphql.ArgumentConfig{Type: userByType},
+						},
+					},
+				},
+			}),
+		})
+		if err == nil || err.Error() != test.expected {
+			t.Fatalf("expected error %q, got: %v", test.expected, err)
+		}
+	}
+}